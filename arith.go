@@ -1,14 +1,11 @@
 package numeric
 
 import (
-	"math/bits"
+	"math"
+	"slices"
 )
 
-type (
-	arithmetic struct{}
-	divUnit    = int64
-	divArray   [8]divUnit
-)
+type arithmetic struct{}
 
 // arith functions are intended for internal calculation logic only.
 // They work with *f24 types in the form z = x op y where op is one of
@@ -17,6 +14,9 @@ type (
 var arith arithmetic
 
 var (
+	ulp       = f24{0, 0, 0, 0, 0, 1} // ulp is the smallest representable step, 1e-36
+	half      = f24{0, 0, 500_000_000, 0, 0, 0}
+	hundred   = f24{0, 100, 0, 0, 0, 0}
 	mulOffset = [6]int{1, 0, -1, -2, -3, -4}
 	powers    = [radixDigits + 1]uint64{1, 10, 100, 1000, 10000, 100_000, 1_000_000, 10_000_000, 100_000_000, 1000_000_000}
 )
@@ -80,12 +80,28 @@ func (arith arithmetic) sub(z, x, y *f24) {
 	arith.add(z, x, &yNeg)
 }
 
-func (arithmetic) mul(z, x, y *f24) {
+// mul performs z = x * y.
+// Special operands give:
+//   - NaN if either operand is NaN.
+//   - an exact 0 if either operand is an exact zero, even when the other overflows.
+//   - NaN for an underflowed zero (~0) times an overflow, as the product is indeterminate.
+//   - an overflow if either operand overflows, keeping any underflow marker.
+func (arith arithmetic) mul(z, x, y *f24) {
 	if x.isNaN() || y.isNaN() {
 		z.setNaN(true)
 		return
 	}
 
+	xZero, yZero := x.isZero(), y.isZero()
+	if (xZero && !x.isUnderflow()) || (yZero && !y.isUnderflow()) {
+		*z = f24{}
+		return
+	}
+	if (xZero && y.isOverflow()) || (yZero && x.isOverflow()) {
+		z.setNaN(true)
+		return
+	}
+
 	isNeg := x.isNeg() != y.isNeg()
 	z.setNeg(isNeg)
 
@@ -93,13 +109,35 @@ func (arithmetic) mul(z, x, y *f24) {
 		z.setUnderflow(true)
 	}
 
-	if !y.isZero() && (x.isOverflow() || y.isOverflow()) {
+	if x.isOverflow() || y.isOverflow() {
 		arith.overflow(z)
 		return
 	}
 
 	var accumulator [12]uint64
+	arith.mulUnits(&accumulator, x, y)
 
+	// check for an overflow.
+	if accumulator[0] != 0 || accumulator[1] != 0 {
+		arith.overflow(z)
+		return
+	}
+	z[0].setVal(uint32(accumulator[2]))
+	z[1].setVal(uint32(accumulator[3]))
+	z[2].setVal(uint32(accumulator[4]))
+	z[3].setVal(uint32(accumulator[5]))
+	z[4].setVal(uint32(accumulator[6]))
+	z[5].setVal(uint32(accumulator[7]))
+	if accumulator[8] != 0 || accumulator[9] != 0 || accumulator[10] != 0 || accumulator[11] != 0 {
+		z.setUnderflow(true)
+		return
+	}
+}
+
+// mulUnits sets accumulator to the full width product of |x| and |y|.
+// accumulator[2:8] align with the units of a f24, the lower units hold the
+// further 36 decimal places and the upper units the overflow.
+func (arithmetic) mulUnits(accumulator *[12]uint64, x, y *f24) {
 	// Multiply 6×6 base-1e9 digits
 	for i := lowIndex; i >= 0; i-- {
 		xi := uint64(x[i].val())
@@ -127,20 +165,10 @@ func (arithmetic) mul(z, x, y *f24) {
 		}
 	}
 
-	// check for an overflow.
-	if accumulator[0] != 0 || accumulator[1] != 0 {
-		arith.overflow(z)
-		return
-	}
-	z[0].setVal(uint32(accumulator[2]))
-	z[1].setVal(uint32(accumulator[3]))
-	z[2].setVal(uint32(accumulator[4]))
-	z[3].setVal(uint32(accumulator[5]))
-	z[4].setVal(uint32(accumulator[6]))
-	z[5].setVal(uint32(accumulator[7]))
-	if accumulator[8] != 0 || accumulator[9] != 0 || accumulator[10] != 0 || accumulator[11] != 0 {
-		z.setUnderflow(true)
-		return
+	// propagate any carries left unchecked.
+	for i := len(accumulator) - 1; i > 0; i-- {
+		accumulator[i-1] += accumulator[i] / radix
+		accumulator[i] %= radix
 	}
 }
 
@@ -171,253 +199,230 @@ func (arith arithmetic) div(z, x, y *f24) {
 		return
 	}
 
-	// arith.divLong(z, x, y)
 	arith.divInner(z, x, y)
 }
 
-func (arithmetic) divInner(z, x, y *f24) {
-	// This is a implementation of the Knuth division algorithm
-	// on digits of radix based integers with a fixed point after 2 digits.
-
-	// step 1 we are going to shift the numerator and denominator
-	// to the left until the first digit of the denominator is non-zero.
-	// we will also shift the numerator to the left
-	var num divArray // Holds extended numerator (192 bits extended to 256 for computation)
-	var den divArray // Holds extended denominator
-
-	var firstNumDigit, firstDenDigit int // Track positions of first non-zero digit in x and y
-	dp := 1                              // Denominator pointer index (starts at 1 for alignment)
-	np := 1                              // Numerator pointer index (starts at 1 for alignment)
-	// Normalize numerator and denominator into divUnit arrays
-	for i := range lenF24 {
-		nv := divUnit(x[i].val()) // Get the value from x[i]
-		dv := divUnit(y[i].val()) // Get the value from y[i]
-
-		// Skip leading zeroes in numerator
-		if firstNumDigit != 0 || nv != 0 {
-			if firstNumDigit == 0 {
-				firstNumDigit = i + 1
-			}
-			num[np] = nv
-			np++
-		}
-
-		// Skip leading zeroes in denominator
-		if firstDenDigit != 0 || dv != 0 {
-			if firstDenDigit == 0 {
-				firstDenDigit = i + 1
-			}
-			den[dp] = dv
-			dp++
-		}
+// muladd performs z = w + x * y, adding the full width product so a single
+// underflow decision is made on the sum. Overflowing operands give the same
+// result as a separate mul and add.
+func (arith arithmetic) muladd(z, w, x, y *f24) {
+	if w.isNaN() || x.isNaN() || y.isNaN() {
+		z.setNaN(true)
+		return
+	}
+	if w.isOverflow() || x.isOverflow() || y.isOverflow() {
+		var p f24
+		arith.mul(&p, x, y)
+		arith.add(z, w, &p)
+		return
 	}
 
-	// Shift is used to place digits relative to the decimal point
-	shift := 1 + firstNumDigit - firstDenDigit
-	if num[1] < den[1] {
-		shift++
+	var product, addend [12]uint64
+	arith.mulUnits(&product, x, y)
+	for i := range lenF24 {
+		addend[i+decIndex] = uint64(w[i].val())
 	}
 
-	// we have the values in num and den non zero at idx 1
-	// now if the first digit < radixHalf we need to normalize.
-	if num[1] < radixHalfI {
-		if normalization := radixHalfI / (num[1] + 1); normalization != 0 {
-			_, np = num.mul(normalization)
-			_, dp = den.mul(normalization)
+	var sum [12]uint64
+	isNeg := w.isNeg()
+	if pNeg := x.isNeg() != y.isNeg(); pNeg == isNeg {
+		var carry uint64
+		for i := len(sum) - 1; i >= 0; i-- {
+			t := product[i] + addend[i] + carry
+			sum[i], carry = t%radix, t/radix
 		}
-
-		// shift to left to remove zeros
-		if np > 0 {
-			p := 0
-			for i := np; i < len(num); i++ {
-				num[p] = num[i]
-				num[i] = 0
-				p++
-			}
+	} else {
+		big, small := &addend, &product
+		if slices.Compare(product[:], addend[:]) > 0 {
+			big, small, isNeg = &product, &addend, pNeg
 		}
-
-		if dp > 0 {
-			p := 0
-			for i := dp; i < len(den); i++ {
-				den[p] = den[i]
-				den[i] = 0
-				p++
-			}
+		var borrow uint64
+		for i := len(sum) - 1; i >= 0; i-- {
+			t := big[i] + radix - small[i] - borrow
+			sum[i], borrow = t%radix, 1-t/radix
 		}
 	}
-	den.trimZero()
-	dEst := den[0]*radixI + den[1]
-
-	// now we have adjusted the values
-	// now we can follow the Knuth algorithm
-	var res divArray     // Holds the raw division result
-	var q, carry divUnit // Holds quotient estimate
-	np = 0
-	for i := range len(res) {
-		if num[np] == 0 && carry == 0 {
-			res[i] = 0
-			_, isZero := num.trimZero()
-			if isZero {
-				break // If numerator is zero, we can stop
-			}
-			continue
-		}
-		q, carry = num.estimate(np, dEst, carry) // Estimate quotient digit
-		// Move to next numerator digit
-		if q == 0 {
-			trimmed, isZero := num.trimZero()
-			if carry == 0 && isZero {
-				break // If numerator is zero, we can stop
-			}
-			if !trimmed {
-				np = 1
-			}
-			continue // If estimate is zero, continue to next digit
-		}
-
-		prod := den
-		for q != 0 {
-			mulCarry, _ := prod.mul(q) // Multiply denominator by quotient estimate
-			prod.shiftCarry(mulCarry)
-			prod.trimZero()
-			rem := num
-			// Subtract product from numerator
-			if borrow := rem.sub(&prod); borrow != 0 {
-				q-- // Retry with smaller quotient if borrow occurred
-				continue
-			}
-
-			// Attempt to move the digits left, if there is carry we handle it here too.
-			num = rem
-			trimmed, _ := num.trimZero()
-			switch {
-			case !trimmed: // carry present
-				np = 1
-				carry = num[0]
-			case num[0] == 0: // new shifted value is zero, clear carry
-				np = 0
-				carry = 0
-			case np != 0: // we still have carry after the shift.
-				np = 1
-				carry = num[0]
-			default:
-				carry = 0
-			}
 
-			break // Successful subtraction, exit loop
-		}
-		res[i] = q
+	defer func() {
+		z.setNeg(shouldBeNeg(z, isNeg))
+	}()
+	z.setUnderflow(w.isUnderflow() || x.isUnderflow() || y.isUnderflow())
+	if sum[0] != 0 || sum[1] != 0 {
+		arith.overflow(z)
+		return
 	}
-
-	// Adjust for a leading zero offset in results.
-	if res[0] == 0 {
-		shift--
+	for i := range lenF24 {
+		z[i].setVal(uint32(sum[i+decIndex]))
 	}
-
-	// correct values for decimal point.
-	for i, v := range res {
-		rp := shift + i
-		if rp >= 0 && rp <= lowIndex {
-			z[rp].setVal(uint32(v))
-		} else if rp < 0 && v > 0 {
-			// overflow.
-			arith.overflow(z)
-			return
-		} else if rp > lowIndex && v != 0 {
-			// If we have a digit beyond the lowIndex, it means overflow.
+	for _, u := range sum[decIndex+lenF24:] {
+		if u != 0 {
 			z.setUnderflow(true)
-			return
+			break
 		}
 	}
 }
 
-// u128 calculates a hi:lo uint64 from the divArray at index i adding in any carry.
-func (d *divArray) u128(i int, carry divUnit) (hi, lo uint64) {
-	// carry * radix2 + d[i] radix + d[i+1]
-	lo = uint64(d[i])*radix + uint64(d[i+1])
-	if carry == 0 {
+// muldiv performs z = x * y / w, dividing the full width product so only
+// the final quotient can overflow. Zero and overflow operands follow mul.
+func (arith arithmetic) muldiv(z, x, y, w *f24) {
+	if x.isNaN() || y.isNaN() || w.isNaN() || w.isZero() {
+		z.setNaN(true)
+		return
+	}
+
+	xZero, yZero := x.isZero(), y.isZero()
+	if (xZero && !x.isUnderflow()) || (yZero && !y.isUnderflow()) {
+		*z = f24{}
+		return
+	}
+	if (xZero && y.isOverflow()) || (yZero && x.isOverflow()) {
+		z.setNaN(true)
 		return
 	}
-	chi, clo := bits.Mul64(uint64(carry)*radix, radix) // Multiply carry by radix
 
-	lo, c := bits.Add64(clo, lo, 0) // add low together
-	hi = chi + c                    // carry from low addition
+	isNeg := x.isNeg() != y.isNeg() != w.isNeg()
+	defer func() {
+		z.setNeg(shouldBeNeg(z, isNeg))
+	}()
 
-	return hi, lo
-}
+	if x.isOverflow() || y.isOverflow() || w.isOverflow() {
+		arith.overflow(z)
+		return
+	}
+	z.setUnderflow(x.isUnderflow() || y.isUnderflow() || w.isUnderflow())
 
-func (d *divArray) estimate(i int, den divUnit, carry divUnit) (divUnit, divUnit) {
-	// use math.bits to calc a integer quotient estimate
-	denU := uint64(den)
+	var accumulator [12]uint64
+	arith.mulUnits(&accumulator, x, y)
 
-	hi, lo := d.u128(i, carry)
-	q, r := bits.Div64(hi, lo, denU)
+	var u [len(accumulator) + 1]uint64
+	copy(u[1:], accumulator[:])
+	arith.divUnits(z, u[:], w)
+}
 
-	if q == 0 {
-		// remainder needs to be reduced by 1 radix as we multiplied by 1 radix in u128.
-		carry = divUnit(r / radix)
-	} else {
-		carry = 0
+// divInner performs |z| = |x| / |y| for non zero x and y.
+//
+// This is Knuth's long division (TAOCP Vol 2, 4.3.1 Algorithm D) on radix units.
+// x and y are treated as 54 digit integers and x is extended by the 4 decimal
+// units, so the integer quotient carries the 36 decimal places of the result.
+func (arith arithmetic) divInner(z, x, y *f24) {
+	var u [lenF24 + lenF24 - decIndex + 1]uint64 // numerator with an extra leading unit for normalization
+	for i := range lenF24 {
+		u[i+1] = uint64(x[i].val())
 	}
-
-	return divUnit(q), carry
+	arith.divUnits(z, u[:], y)
 }
 
-func (d *divArray) sub(other *divArray) divUnit {
-	// Subtract each element of other from da and handle underflow
-	var borrow divUnit
-	for i := len(d) - 1; i >= 0; i-- {
-		diff := d[i] - other[i] - borrow
-		if diff < 0 {
-			diff += radixI
-			borrow = 1
-		} else {
-			borrow = 0
+// divUnits performs |z| = u / |y| by long division, where u holds the
+// numerator units scaled by 1e72 after a spare leading zero unit. u is
+// overwritten with the remainder.
+func (arithmetic) divUnits(z *f24, u []uint64, y *f24) {
+	m := len(u) - 1        // index of the lowest numerator unit
+	offset := m - lowIndex // u index of z[0]
+
+	var v [lenF24]uint64
+	var n int // number of significant denominator units
+
+	for i := range lenF24 {
+		if dv := uint64(y[i].val()); n != 0 || dv != 0 {
+			v[n] = dv
+			n++
 		}
-		d[i] = diff
 	}
-	return borrow
-}
 
-func (d *divArray) mul(x divUnit) (divUnit, int) {
-	// Multiply each element by x and handle overflow
-	var carry divUnit
-	var firstNonZero int // Track first non-zero digit position
-	for i := len(d) - 1; i >= 0; i-- {
-		product := d[i]*x + carry
-		d[i] = product % radixI  // Store the result in the current position
-		carry = product / radixI // Carry over to the next position
-		if d[i] != 0 {
-			firstNonZero = i // Update first non-zero position
+	// set the quotient unit aligned with u[k], returning false on overflow.
+	set := func(k int, q uint64) bool {
+		switch i := k - offset; {
+		case i >= 0:
+			z[i].setVal(uint32(q))
+		case q != 0:
+			arith.overflow(z)
+			return false
 		}
+		return true
 	}
-	return carry, firstNonZero
-}
 
-func (d *divArray) shiftCarry(carry divUnit) {
-	// Shift the carry to the left, adding it to the next element
-	if carry == 0 {
-		return // No carry to shift
+	if n == 1 {
+		// single unit denominators use short division.
+		var r uint64
+		for k := range u {
+			t := r*radix + u[k]
+			if !set(k, t/v[0]) {
+				return
+			}
+			r = t % v[0]
+		}
+		if r != 0 {
+			z.setUnderflow(true)
+		}
+		return
 	}
-	for i := len(d) - 1; i > 0; i-- {
-		d[i] = d[i-1]
+
+	// normalize so the leading denominator unit is at least radix/2.
+	if d := radix / (v[0] + 1); d > 1 {
+		var carry uint64
+		for i := m; i >= 0; i-- {
+			t := u[i]*d + carry
+			u[i], carry = t%radix, t/radix
+		}
+		carry = 0
+		for i := n - 1; i >= 0; i-- {
+			t := v[i]*d + carry
+			v[i], carry = t%radix, t/radix
+		}
 	}
-	d[0] = carry
-}
 
-func (d *divArray) trimZero() (trimmed bool, isZero bool) {
-	if d[0] != 0 {
-		return false, false // No leading zero to trim
+	for j := 0; j <= m-n; j++ {
+		// estimate the quotient unit from the leading units.
+		t := u[j]*radix + u[j+1]
+		qhat, rhat := t/v[0], t%v[0]
+		for qhat >= radix || qhat*v[1] > rhat*radix+u[j+2] {
+			qhat--
+			rhat += v[0]
+			if rhat >= radix {
+				break
+			}
+		}
+
+		// multiply and subtract qhat * v from u[j:j+n+1].
+		var carry uint64
+		var borrow int64
+		for i := n - 1; i >= 0; i-- {
+			p := qhat*v[i] + carry
+			carry = p / radix
+			d := int64(u[j+i+1]) - int64(p%radix) - borrow
+			borrow = 0
+			if d < 0 {
+				d += radixI
+				borrow = 1
+			}
+			u[j+i+1] = uint64(d)
+		}
+		d := int64(u[j]) - int64(carry) - borrow
+		if d < 0 {
+			// estimate was one too large, add back v.
+			qhat--
+			d += radixI
+			carry = 0
+			for i := n - 1; i >= 0; i-- {
+				s := u[j+i+1] + v[i] + carry
+				u[j+i+1], carry = s%radix, s/radix
+			}
+			d = (d + int64(carry)) % radixI
+		}
+		u[j] = uint64(d)
+
+		if !set(j+n, qhat) {
+			return
+		}
 	}
-	isZero = true // Assume zero unless we find a non-zero digit
-	for i := 1; i < len(d)-1; i++ {
-		v := d[i]
-		if v != 0 {
-			isZero = false // Found a non-zero digit
+
+	// any remainder means the result is inexact.
+	for _, r := range u[m-n+1:] {
+		if r != 0 {
+			z.setUnderflow(true)
+			return
 		}
-		d[i-1] = v
 	}
-	return true, isZero
 }
 
 func (arithmetic) negate(z, x *f24) {
@@ -503,6 +508,11 @@ func (arith arithmetic) compare(x, y *f24) int {
 		return 1 // NaN's are not equal but for comparison we will treat as less
 	}
 
+	// exact zeros are equal whatever their sign.
+	if x.isZero() && y.isZero() && !x.isUnderflow() && !y.isUnderflow() {
+		return 0
+	}
+
 	var cmp int
 
 	xs, ys := x.isNeg(), y.isNeg()
@@ -537,10 +547,41 @@ func (arith arithmetic) compare(x, y *f24) int {
 	return cmp
 }
 
+// order compares x and y like compare, but orders overflows beyond every
+// representable value: a positive overflow is the greatest value and a
+// negative overflow the least.
+func (arith arithmetic) order(x, y *f24) int {
+	xr, yr := overflowRank(x), overflowRank(y)
+	switch {
+	case xr < yr:
+		return -1
+	case xr > yr:
+		return 1
+	case xr != 0:
+		return 0
+	}
+	return arith.compare(x, y)
+}
+
+// overflowRank returns 1 for a positive overflow, -1 for a negative overflow and 0 otherwise.
+func overflowRank(x *f24) int {
+	switch {
+	case x.isNaN() || !x.isOverflow():
+		return 0
+	case x.isNeg():
+		return -1
+	default:
+		return 1
+	}
+}
+
 func (arith arithmetic) equal(x, y *f24) bool {
 	if arith.hasExceptionalState(x) || arith.hasExceptionalState(y) {
 		return false
 	}
+	if x.isZero() && y.isZero() { // zero equals zero whatever its sign.
+		return true
+	}
 	return *x == *y
 }
 
@@ -703,6 +744,10 @@ func (arith arithmetic) round(z, x *f24, y int, mode RoundMode) {
 	case x.isZero():
 	case y < 0:
 		z.setNaN(true)
+	case y >= maxDecimalPlaces:
+		// every stored digit is kept, only the underflow is removed.
+		*z = *x
+		z.setUnderflow(false)
 	default:
 		idx := decIndex + y/radixDigits
 		v := uint64(x[idx].val())
@@ -725,13 +770,30 @@ func (arith arithmetic) round(z, x *f24, y int, mode RoundMode) {
 			}
 		case RoundTowards:
 		case RoundHalfDown:
-			if rem > p/2 {
+			if rem > p/2 || (rem == p/2 && !arith.zeroFrom(x, idx+1)) {
 				v += p
 			}
 		case RoundHalfUp:
 			if (rem + 1) > p/2 {
 				v += p
 			}
+		case RoundCeil:
+			if !isNeg && (rem > 0 || !arith.zeroFrom(x, idx+1)) {
+				v += p
+			}
+		case RoundFloor:
+			if isNeg && (rem > 0 || !arith.zeroFrom(x, idx+1)) {
+				v += p
+			}
+		case RoundHalfEven:
+			switch {
+			case rem > p/2:
+				v += p
+			case rem == p/2:
+				if !arith.zeroFrom(x, idx+1) || arith.keptDigitOdd(x, idx, v, pow) {
+					v += p
+				}
+			}
 		}
 		carry := v / radix
 		v %= radix
@@ -741,6 +803,11 @@ func (arith arithmetic) round(z, x *f24, y int, mode RoundMode) {
 			xi %= radix
 			z[i].setVal(uint32(xi))
 		}
+		if carry != 0 {
+			// rounded beyond the largest whole value.
+			arith.overflow(z)
+			return
+		}
 		z[idx].setVal(uint32(v))
 		for i := idx + 1; i < lenF24; i++ {
 			z[i].setVal(0)
@@ -748,6 +815,27 @@ func (arith arithmetic) round(z, x *f24, y int, mode RoundMode) {
 	}
 }
 
+// zeroFrom returns true if all the digits of x from index i onward are zero.
+func (arithmetic) zeroFrom(x *f24, i int) bool {
+	for ; i < lenF24; i++ {
+		if x[i].val() != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// keptDigitOdd returns true if the last digit retained by rounding is odd.
+// v is the value at idx with the discarded remainder removed, and pow the
+// number of digits discarded from it. When a whole unit is discarded the kept
+// digit is the last digit of the previous unit.
+func (arithmetic) keptDigitOdd(x *f24, idx int, v uint64, pow int) bool {
+	if pow == radixDigits {
+		return x[idx-1].val()%2 == 1
+	}
+	return (v/powers[pow])%2 == 1
+}
+
 func (arith arithmetic) quanta(z, x, y *f24, mode RoundMode) {
 	var w f24
 	arith.div(&w, x, y)
@@ -762,6 +850,11 @@ func (arith arithmetic) quanta(z, x, y *f24, mode RoundMode) {
 }
 
 func (arith arithmetic) divRem(q, r, x, y *f24) {
+	if arith.isExactInt(x) && arith.isExactInt(y) && !y.isZero() {
+		arith.intDivRem(q, r, x, y)
+		return
+	}
+
 	var w f24
 	arith.div(&w, x, y)
 	if w.isNaN() || w.isOverflow() {
@@ -776,6 +869,174 @@ func (arith arithmetic) divRem(q, r, x, y *f24) {
 	arith.sub(r, x, &u)
 }
 
+// isExactInt returns true if x is a whole number without NaN, overflow or underflow.
+func (arith arithmetic) isExactInt(x *f24) bool {
+	return !arith.hasExceptionalState(x) && arith.zeroFrom(x, decIndex)
+}
+
+// intDivRem performs the truncated division of the exact integers x and y,
+// setting the quotient q and remainder r, using 64 bit integer arithmetic.
+// y must not be zero.
+func (arithmetic) intDivRem(q, r, x, y *f24) {
+	xw := uint64(x[0].val())*radix + uint64(x[1].val())
+	yw := uint64(y[0].val())*radix + uint64(y[1].val())
+	qw, rw := xw/yw, xw%yw
+
+	q[0].setVal(uint32(qw / radix))
+	q[1].setVal(uint32(qw % radix))
+	q.setNeg(shouldBeNeg(q, x.isNeg() != y.isNeg()))
+	r[0].setVal(uint32(rw / radix))
+	r[1].setVal(uint32(rw % radix))
+	r.setNeg(shouldBeNeg(r, x.isNeg()))
+}
+
+// pow performs z = x^exp using exponentiation by squaring.
+// Negative exponents take the reciprocal of the positive power.
+// x^0 is 1 for every non NaN x, including zero.
+func (arith arithmetic) pow(z, x *f24, exp int) {
+	if x.isNaN() {
+		z.setNaN(true)
+		return
+	}
+
+	e := uint(exp)
+	if exp < 0 {
+		e = uint(-exp)
+	}
+
+	var result f24
+	result[1].setVal(1)
+	base := *x
+	for e > 0 {
+		if e&1 == 1 {
+			var t f24
+			arith.mul(&t, &result, &base)
+			result = t
+			if result.isOverflow() {
+				break
+			}
+		}
+		e >>= 1
+		if e == 0 {
+			break
+		}
+		var t f24
+		arith.mul(&t, &base, &base)
+		base = t
+		if base.isOverflow() {
+			// any remaining bit multiplies by an overflowing (positive) square.
+			arith.mul(&t, &result, &base)
+			result = t
+			break
+		}
+	}
+
+	if exp >= 0 {
+		*z = result
+		return
+	}
+
+	if result.isOverflow() {
+		// the reciprocal of an overflow is too small to represent.
+		z.setUnderflow(true)
+		z.setNeg(result.isNeg())
+		return
+	}
+
+	var one f24
+	one[1].setVal(1)
+	arith.div(z, &one, &result)
+}
+
+// sqrt performs z = √x using Newton-Raphson iteration seeded from a float64 estimate.
+// The result is truncated to 36 decimal places and marked as underflow when inexact.
+func (arith arithmetic) sqrt(z, x *f24) {
+	switch {
+	case x.isNaN() || (x.isNeg() && !x.isZero()):
+		z.setNaN(true)
+		return
+	case x.isOverflow():
+		arith.overflow(z)
+		return
+	case x.isZero():
+		z.setUnderflow(x.isUnderflow())
+		return
+	}
+
+	d := x.Digits()
+	y := f24Float64(math.Sqrt(d.Float64()))
+	y.setUnderflow(false)
+	if y.isZero() {
+		// too small for a float64 estimate, seed from the magnitude instead.
+		exp, _ := arith.log10Floor(x)
+		y = f24{}
+		arith.pow10(&y, (exp+1)/2)
+	}
+
+	// y' = (y + x/y) / 2, until the estimate stops changing.
+	for range 100 {
+		var q, s, next f24
+		arith.div(&q, x, &y)
+		arith.add(&s, &y, &q)
+		arith.mul(&next, &s, &half)
+		next.setUnderflow(false)
+		if next == y {
+			break
+		}
+		y = next
+	}
+
+	// settle on the largest y where y² ≤ x.
+	var sq f24
+	for {
+		arith.mul(&sq, &y, &y)
+		if !sq.isOverflow() && arith.compare(&sq, x) <= 0 {
+			break
+		}
+		var t f24
+		arith.sub(&t, &y, &ulp)
+		y, sq = t, f24{}
+	}
+	for {
+		var t, tsq f24
+		arith.add(&t, &y, &ulp)
+		arith.mul(&tsq, &t, &t)
+		if tsq.isOverflow() || arith.compare(&tsq, x) > 0 {
+			break
+		}
+		y, sq = t, tsq
+	}
+
+	*z = y
+	z.setUnderflow(x.isUnderflow() || !arith.equal(&sq, x))
+}
+
+// log10Floor returns floor(log10(|x|)) for a non zero x.
+// ok is false when x is zero, NaN or overflowing.
+func (arithmetic) log10Floor(x *f24) (exp int, ok bool) {
+	if x.isNaN() || x.isOverflow() {
+		return 0, false
+	}
+	for i := range lenF24 {
+		v := uint64(x[i].val())
+		if v == 0 {
+			continue
+		}
+		d := radixDigits
+		for d > 1 && v < powers[d-1] {
+			d--
+		}
+		return d - 1 + radixDigits*(1-i), true
+	}
+	return 0, false
+}
+
+// pow10 sets z = 10^exp, exp must be in the range -36 to 17.
+func (arithmetic) pow10(z *f24, exp int) {
+	shifted := exp + maxDecimalPlaces // digits above 10^-36, the lowest unit
+	z[lowIndex-shifted/radixDigits].setVal(uint32(powers[shifted%radixDigits]))
+}
+
 func shouldBeNeg(x *f24, isNeg bool) bool {
 	if x.isNaN() {
 		return false
@@ -153,6 +153,92 @@ func TestF24MulAndDiv(t *testing.T) {
 	}
 }
 
+func TestF24MulSpecialOperands(t *testing.T) {
+	const (
+		max    = "<999999999999999999.999999999999999999999999999999999999"
+		negMax = "-<999999999999999999.999999999999999999999999999999999999"
+	)
+	operands := []string{"0", "~0", "~-0", max, negMax, "NaN", "2", "-2"}
+
+	// want[i][j] is operands[i] * operands[j].
+	want := [][]string{
+		{"0", "0", "0", "0", "0", "NaN", "0", "0"},
+		{"0", "~0", "~-0", "NaN", "NaN", "NaN", "~0", "~-0"},
+		{"0", "~-0", "~0", "NaN", "NaN", "NaN", "~-0", "~0"},
+		{"0", "NaN", "NaN", max, negMax, "NaN", max, negMax},
+		{"0", "NaN", "NaN", negMax, max, "NaN", negMax, max},
+		{"NaN", "NaN", "NaN", "NaN", "NaN", "NaN", "NaN", "NaN"},
+		{"0", "~0", "~-0", max, negMax, "NaN", "4", "-4"},
+		{"0", "~-0", "~0", negMax, max, "NaN", "-4", "4"},
+	}
+
+	for i, xStr := range operands {
+		for j, yStr := range operands {
+			t.Run(fmt.Sprintf("mul(%s,%s)", xStr, yStr), func(t *testing.T) {
+				x, err1 := f24String(xStr)
+				y, err2 := f24String(yStr)
+				if err1 != nil || err2 != nil {
+					t.Fatalf("Invalid input: %v or %v", err1, err2)
+				}
+
+				var z f24
+				arith.mul(&z, &x, &y)
+				d := z.Digits()
+				if got := d.String(); got != want[i][j] {
+					t.Errorf("mul(%q, %q) = %q, want %q", xStr, yStr, got, want[i][j])
+				}
+			})
+		}
+	}
+}
+
+func TestF24MulZeroResetsResult(t *testing.T) {
+	zero, _ := f24String("0")
+	three, _ := f24String("3")
+	for _, s := range []string{"~-7.5", "-<1"} {
+		// a result aliasing an operand.
+		z, _ := f24String(s)
+		arith.mul(&z, &z, &zero)
+		d := z.Digits()
+		if got := d.String(); got != "0" {
+			t.Errorf("mul(%s, 0) into itself = %q, want 0", s, got)
+		}
+
+		// a result holding another value.
+		z, _ = f24String(s)
+		arith.mul(&z, &zero, &three)
+		d = z.Digits()
+		if got := d.String(); got != "0" {
+			t.Errorf("mul(0, 3) into %s = %q, want 0", s, got)
+		}
+	}
+}
+
+func TestF24MulDivZeroResetsResult(t *testing.T) {
+	zero, _ := f24String("0")
+	three, _ := f24String("3")
+	for _, s := range []string{"~-7.5", "-<1"} {
+		z, _ := f24String(s)
+		arith.muldiv(&z, &z, &zero, &three)
+		d := z.Digits()
+		if got := d.String(); got != "0" {
+			t.Errorf("muldiv(%s, 0, 3) into itself = %q, want 0", s, got)
+		}
+	}
+}
+
+func TestF24MulUnderflowWithOverflow(t *testing.T) {
+	x, _ := f24String("~1")
+	y, _ := f24String("<1")
+
+	var z f24
+	arith.mul(&z, &x, &y)
+	d := z.Digits()
+	if got, want := d.String(), "~<999999999999999999.999999999999999999999999999999999999"; got != want {
+		t.Errorf("mul(~1, <1) = %q, want %q", got, want)
+	}
+}
+
 func TestDiv(t *testing.T) {
 	type testCase struct {
 		xStr, yStr         string
@@ -202,6 +288,14 @@ func TestDiv(t *testing.T) {
 		{"1", "2e-36", "<999999999999999999.999999999999999999999999999999999999", false},
 		{"2e17", "2e-36", "<999999999999999999.999999999999999999999999999999999999", false},
 		{"2e-36", "2e-36", "1", false},
+
+		// Leading units of the numerator smaller than the denominator
+		{"999999998000000001", "999999999", "999999999", false},
+		{"999999998", "999999999", "~0.999999998999999998999999998999999998", true},
+		{"1000000000", "999999999", "~1.000000001000000001000000001000000001", true},
+		{"999999999999999999", "999999999.999999999", "1000000000", false},
+		{"123456789123456789", "123456789.123456789", "1000000000", false},
+		{"0.000000000000000000000000000000000001", "0.000000000000000000000000000000000003", "~0.333333333333333333333333333333333333", true},
 	}
 
 	for _, tc := range tests {
@@ -251,6 +345,27 @@ func TestF24Round(t *testing.T) {
 		{"123.000005", 5, RoundHalfDown, "123"},
 		{"123.0000055", 5, RoundHalfDown, "123.00001"},
 
+		{"123.5", 0, RoundHalfEven, "124"},
+		{"124.5", 0, RoundHalfEven, "124"},
+		{"124.500000001", 0, RoundHalfEven, "125"},
+		{"-124.5", 0, RoundHalfEven, "-124"},
+		{"123.000005", 5, RoundHalfEven, "123"},
+		{"123.000015", 5, RoundHalfEven, "123.00002"},
+		{"0.1234567885", 9, RoundHalfEven, "0.123456788"},
+		{"0.1234567895", 9, RoundHalfEven, "0.12345679"},
+		{"0.1234567885000000000000001", 9, RoundHalfEven, "0.123456789"},
+		{"0.000000000000000000000000000000000025", 35, RoundHalfEven, "0.00000000000000000000000000000000002"},
+		{"0.000000000000000000000000000000000035", 35, RoundHalfEven, "0.00000000000000000000000000000000004"},
+
+		{"1.234", 2, RoundCeil, "1.24"},
+		{"-1.234", 2, RoundCeil, "-1.23"},
+		{"1.234", 2, RoundFloor, "1.23"},
+		{"-1.234", 2, RoundFloor, "-1.24"},
+		{"-1.230000000000000001", 2, RoundFloor, "-1.24"},
+		{"-1.23", 2, RoundFloor, "-1.23"},
+
+		{"~0.000000000000000000000000000000000001", 36, RoundAway, "0.000000000000000000000000000000000001"},
+		{"-1.5", 40, RoundHalfUp, "-1.5"},
 		{"999999999.999999999", -1, RoundAway, "NaN"},
 		{"0.0000000001", 9, RoundTowards, "0"},
 		{"NaN", 0, RoundHalfUp, "NaN"},
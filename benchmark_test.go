@@ -15,6 +15,9 @@ var (
 
 	c, _ = FromString("12345.6")
 	d, _ = FromString("1.2")
+
+	e = FromInt(123456789012345)
+	f = FromInt(-98765)
 )
 
 func BenchmarkFromString(bm *testing.B) {
@@ -59,6 +62,18 @@ func BenchmarkDivRem(bm *testing.B) {
 	}
 }
 
+func BenchmarkDivRemInt(bm *testing.B) {
+	for i := 0; i < bm.N; i++ {
+		_, _ = e.DivRem(f)
+	}
+}
+
+func BenchmarkIntDiv(bm *testing.B) {
+	for i := 0; i < bm.N; i++ {
+		_ = e.IntDiv(f)
+	}
+}
+
 func BenchmarkRound(bm *testing.B) {
 	for i := 0; i < bm.N; i++ {
 		_ = a.Round(4, RoundHalfUp)
@@ -149,7 +164,7 @@ go test -bench=BenchmarkFormat -cpuprofile=cpu.prof -memprofile=mem.prof -benchm
 func BenchmarkFormat(bm *testing.B) {
 	var buf [128]byte
 	for i := 0; i < bm.N; i++ {
-		fmt.Appendf(buf[:0], "%s", a)
+		_ = fmt.Appendf(buf[:0], "%s", a)
 	}
 }
 
@@ -203,3 +218,15 @@ func BenchmarkArithmeticOps(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkFromIntString(bm *testing.B) {
+	for i := 0; i < bm.N; i++ {
+		_, _ = FromIntString("123456789012345")
+	}
+}
+
+func BenchmarkFromStringInt(bm *testing.B) {
+	for i := 0; i < bm.N; i++ {
+		_, _ = FromString("123456789012345")
+	}
+}
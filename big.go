@@ -0,0 +1,104 @@
+package numeric
+
+import (
+	"math/big"
+)
+
+var (
+	// maxBigInt is the largest whole value that can be represented.
+	maxBigInt = new(big.Int).SetUint64(maxValue)
+
+	// scaleBigInt is 10^36, the scale of the f24 units as an integer.
+	scaleBigInt = new(big.Int).Exp(big.NewInt(10), big.NewInt(maxDecimalPlaces), nil)
+)
+
+// FromBigInt creates a Numeric from a big.Int.
+// Values with a magnitude above 999999999999999999 overflow, and a nil value returns NaN.
+func FromBigInt(b *big.Int) Numeric {
+	if b == nil {
+		return NaN()
+	}
+	if b.CmpAbs(maxBigInt) > 0 {
+		return Numeric{z: overflow(b.Sign() < 0)}
+	}
+	return Numeric{z: f24Int(b.Int64())}
+}
+
+// BigInt converts the Numeric to a big.Int, discarding any fractional part.
+// NaN returns 0 and overflows return the largest whole value, matching Int.
+func (n Numeric) BigInt() *big.Int {
+	return new(big.Int).SetInt64(n.Int())
+}
+
+// FromRat creates a Numeric from a big.Rat, dividing the numerator by the
+// denominator at full precision. Non terminating (or over long) decimal
+// expansions are truncated to 36 decimal places and marked as underflow.
+// A nil value returns NaN.
+func FromRat(r *big.Rat) Numeric {
+	if r == nil {
+		return NaN()
+	}
+
+	num, den := r.Num(), r.Denom()
+	if num.CmpAbs(maxBigInt) <= 0 && den.CmpAbs(maxBigInt) <= 0 {
+		x, y := f24Int(num.Int64()), f24Int(den.Int64())
+		var z f24
+		arith.div(&z, &x, &y)
+		return Numeric{z: z}
+	}
+
+	// beyond the whole digit range, scale and divide as big integers.
+	q := new(big.Int).Mul(scaleBigInt, num)
+	q, rem := q.QuoRem(q, den, new(big.Int))
+	return Numeric{z: f24ScaledBigInt(q, num.Sign() < 0, rem.Sign() != 0)}
+}
+
+// f24ScaledBigInt creates a f24 from q, a value scaled by 10^36.
+func f24ScaledBigInt(q *big.Int, isNeg, inexact bool) f24 {
+	u := new(big.Int).Abs(q)
+
+	var f f24
+	unit := new(big.Int)
+	r := big.NewInt(int64(radix))
+	for i := lowIndex; i >= 0; i-- {
+		u.QuoRem(u, r, unit)
+		f[i].setVal(uint32(unit.Uint64()))
+	}
+	if u.Sign() != 0 {
+		return overflow(isNeg)
+	}
+	f.setUnderflow(inexact)
+	f.setNeg(shouldBeNeg(&f, isNeg))
+	return f
+}
+
+// BigFloat converts the Numeric to a big.Float with prec bits of mantissa,
+// correctly rounding the exact decimal value (not via float64). A prec of 0
+// follows big.Float.SetRat, using at least 64 bits.
+//
+// big.Float has no NaN, so NaN returns nil. Overflows return +Inf or -Inf,
+// and underflows convert the stored value.
+func (n Numeric) BigFloat(prec uint) *big.Float {
+	switch {
+	case n.z.isNaN():
+		return nil
+	case n.z.isOverflow():
+		return new(big.Float).SetPrec(prec).SetInf(n.z.isNeg())
+	}
+	r := new(big.Rat).SetFrac(n.z.scaledBigInt(), scaleBigInt)
+	return new(big.Float).SetPrec(prec).SetRat(r)
+}
+
+// scaledBigInt returns the value of f scaled by 10^36 as a big.Int.
+func (f *f24) scaledBigInt() *big.Int {
+	b := new(big.Int)
+	r := big.NewInt(int64(radix))
+	for i := range lenF24 {
+		b.Mul(b, r)
+		b.Add(b, big.NewInt(int64(f[i].val())))
+	}
+	if f.isNeg() {
+		b.Neg(b)
+	}
+	return b
+}
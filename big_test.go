@@ -0,0 +1,170 @@
+package numeric
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func TestFromBigInt(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+
+	tests := []testCase{
+		{"0", "0"},
+		{"1", "1"},
+		{"-1", "-1"},
+		{"123456789012345678", "123456789012345678"},
+		{"999999999999999999", "999999999999999999"},
+		{"-999999999999999999", "-999999999999999999"},
+		{"1000000000000000000", "<999999999999999999.999999999999999999999999999999999999"},
+		{"-1000000000000000000", "-<999999999999999999.999999999999999999999999999999999999"},
+		{"123456789012345678901234567890", "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			b, ok := new(big.Int).SetString(tc.input, 10)
+			if !ok {
+				t.Fatalf("invalid big.Int %q", tc.input)
+			}
+			if got := FromBigInt(b).String(); got != tc.expected {
+				t.Errorf("FromBigInt(%s) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+
+	if n := FromBigInt(nil); !n.IsNaN() {
+		t.Errorf("FromBigInt(nil) = %q, want NaN", n.String())
+	}
+}
+
+func TestNumericBigInt(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+
+	tests := []testCase{
+		{"0", "0"},
+		{"42", "42"},
+		{"-42", "-42"},
+		{"1.999", "1"},
+		{"-1.999", "-1"},
+		{"~0.5", "0"},
+		{"999999999999999999", "999999999999999999"},
+		{"-999999999999999999.999", "-999999999999999999"},
+		{"<1", "999999999999999999"},
+		{"NaN", "0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.BigInt().String(); got != tc.expected {
+				t.Errorf("BigInt(%q) = %s, want %s", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestBigIntRoundTrip(t *testing.T) {
+	for _, s := range []string{"0", "7", "-7", "999999999", "1000000000", "-1000000001", "999999999999999998", "999999999999999999", "-999999999999999999"} {
+		b, _ := new(big.Int).SetString(s, 10)
+		if got := FromBigInt(b).BigInt(); got.Cmp(b) != 0 {
+			t.Errorf("FromBigInt(%s).BigInt() = %s", s, got)
+		}
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	type testCase struct {
+		rat      string
+		expected string
+	}
+
+	tests := []testCase{
+		{"0", "0"},
+		{"1/8", "0.125"},
+		{"-1/8", "-0.125"},
+		{"1/3", "~0.333333333333333333333333333333333333"},
+		{"-2/3", "~-0.666666666666666666666666666666666666"},
+		{"22/7", "~3.142857142857142857142857142857142857"},
+		{"10/4", "2.5"},
+		{"999999999999999999/1", "999999999999999999"},
+		{"1/1000000000000000000000000000000000000", "0.000000000000000000000000000000000001"},
+		{"1/10000000000000000000000000000000000000", "~0"},
+		{"-1/10000000000000000000000000000000000000", "~-0"},
+		{"123456789012345678901/1000000000000000000000", "0.123456789012345678901"},
+		{"10000000000000000000/3", "<999999999999999999.999999999999999999999999999999999999"},
+		{"-10000000000000000000/1", "-<999999999999999999.999999999999999999999999999999999999"},
+		{"1/3000000000000000000", "~0.000000000000000000333333333333333333"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rat, func(t *testing.T) {
+			r, ok := new(big.Rat).SetString(tc.rat)
+			if !ok {
+				t.Fatalf("invalid big.Rat %q", tc.rat)
+			}
+			want, err := FromString(tc.expected)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.expected, err)
+			}
+			got := FromRat(r)
+			if got != want || got.String() != tc.expected {
+				t.Errorf("FromRat(%s) = %q, want %q", tc.rat, got.String(), tc.expected)
+			}
+		})
+	}
+
+	if n := FromRat(nil); !n.IsNaN() {
+		t.Errorf("FromRat(nil) = %q, want NaN", n.String())
+	}
+}
+
+func TestNumericBigFloat(t *testing.T) {
+	type testCase struct {
+		input    string
+		prec     uint
+		expected string // formatted with %.40g
+	}
+
+	tests := []testCase{
+		{"0", 53, "0"},
+		{"0.1", 53, "0.1000000000000000055511151231257827021182"},
+		{"0.1", 200, "0.1"},
+		{"-2.5", 0, "-2.5"},
+		{"123456789012345678.123456789012345678901234567890123456", 64, "123456789012345678.125"},
+		{"123456789012345678.123456789012345678901234567890123456", 256, "123456789012345678.1234567890123456789012"},
+		{"0.000000000000000000000000000000000001", 113, "9.99999999999999999999999999999999934444e-37"},
+		{"~0.333333333333333333333333333333333333", 200, "0.333333333333333333333333333333333333"},
+		{"<1", 53, "+Inf"},
+		{"-<1", 53, "-Inf"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			f := n.BigFloat(tc.prec)
+			if tc.prec != 0 && f.Prec() != tc.prec {
+				t.Errorf("BigFloat(%q).Prec() = %d, want %d", tc.input, f.Prec(), tc.prec)
+			}
+			if got := fmt.Sprintf("%.40g", f); got != tc.expected {
+				t.Errorf("BigFloat(%q, %d) = %s, want %s", tc.input, tc.prec, got, tc.expected)
+			}
+		})
+	}
+
+	if f := NaN().BigFloat(53); f != nil {
+		t.Errorf("NaN().BigFloat() = %v, want nil", f)
+	}
+}
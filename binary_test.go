@@ -0,0 +1,179 @@
+package numeric
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestNumericGobRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0", "1", "-1", "123.456", "-0.000000000000000000000000000000000001",
+		"999999999999999999.999999999999999999999999999999999999",
+		"~0.333", "~-0", "<1", "-<1", "~-<1", "NaN",
+	}
+
+	for _, s := range inputs {
+		t.Run(s, func(t *testing.T) {
+			n, err := FromString(s)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", s, err)
+			}
+
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(n); err != nil {
+				t.Fatalf("Encode(%q) failed: %v", s, err)
+			}
+			var got Numeric
+			if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+				t.Fatalf("Decode(%q) failed: %v", s, err)
+			}
+			if got.z != n.z {
+				t.Errorf("gob round trip of %q = %v, want %v", s, got.z, n.z)
+			}
+		})
+	}
+}
+
+func TestNumericGobEncodeLayout(t *testing.T) {
+	n, _ := FromString("-1.5")
+	b, err := n.GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode failed: %v", err)
+	}
+	want := []byte{
+		0x80, 0, 0, 0,
+		0, 0, 0, 1,
+		0x1d, 0xcd, 0x65, 0x00,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+	}
+	if !bytes.Equal(b, want) {
+		t.Errorf("GobEncode(-1.5) = %x, want %x", b, want)
+	}
+}
+
+func TestNumericGobDecodeInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":      {},
+		"short":      make([]byte, binaryLen-1),
+		"long":       make([]byte, binaryLen+1),
+		"unit range": append(make([]byte, binaryLen-4), 0x3b, 0x9a, 0xca, 0x00),
+	}
+
+	for name, data := range tests {
+		n := FromInt(7)
+		if err := n.GobDecode(data); !errors.Is(err, ErrInvalidBinaryEncoding) {
+			t.Errorf("%s: GobDecode error = %v, want %v", name, err, ErrInvalidBinaryEncoding)
+		}
+		if n != FromInt(7) {
+			t.Errorf("%s: GobDecode modified value to %s", name, n)
+		}
+	}
+}
+
+func TestNumericGobDecodeNoAllocs(t *testing.T) {
+	b, _ := FromInt(42).GobEncode()
+	var n Numeric
+	if allocs := testing.AllocsPerRun(100, func() { _ = n.GobDecode(b) }); allocs != 0 {
+		t.Errorf("GobDecode allocs = %v, want 0", allocs)
+	}
+}
+
+func TestNumericMarshalBinaryRoundTrip(t *testing.T) {
+	inputs := []string{"0", "-42.5", "0.000000000000000000000000000000000001", "~1.5", "~-0", "<1", "-<1", "NaN"}
+
+	for _, s := range inputs {
+		n, _ := FromString(s)
+		data, err := n.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%q) failed: %v", s, err)
+		}
+		if len(data) != binaryLen {
+			t.Errorf("MarshalBinary(%q) length = %d, want %d", s, len(data), binaryLen)
+		}
+
+		var got Numeric
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%q) failed: %v", s, err)
+		}
+		if got.z != n.z {
+			t.Errorf("binary round trip of %q = %v, want %v", s, got.z, n.z)
+		}
+		if got.IsNaN() != n.IsNaN() || got.HasOverflow() != n.HasOverflow() ||
+			got.HasUnderflow() != n.HasUnderflow() || got.z.isNeg() != n.z.isNeg() {
+			t.Errorf("binary round trip of %q changed flags", s)
+		}
+	}
+}
+
+func TestNumericUnmarshalBinaryInvalid(t *testing.T) {
+	for _, l := range []int{0, 1, binaryLen - 1, binaryLen + 1, 2 * binaryLen} {
+		var n Numeric
+		if err := n.UnmarshalBinary(make([]byte, l)); !errors.Is(err, ErrInvalidBinaryEncoding) {
+			t.Errorf("UnmarshalBinary(len %d) error = %v, want %v", l, err, ErrInvalidBinaryEncoding)
+		}
+	}
+}
+
+func TestNumericProtoRoundTrip(t *testing.T) {
+	inputs := []string{"0", "-42.5", "0.000000000000000000000000000000000001", "~1.5", "~-0", "<1", "-<1", "NaN"}
+
+	for _, s := range inputs {
+		n, _ := FromString(s)
+		data := n.MarshalProto()
+		if len(data) != 1+binaryLen || data[0] != protoVersion {
+			t.Errorf("MarshalProto(%q) = %x, want version %d and length %d", s, data, protoVersion, 1+binaryLen)
+		}
+
+		var got Numeric
+		if err := got.UnmarshalProto(data); err != nil {
+			t.Fatalf("UnmarshalProto(%q) failed: %v", s, err)
+		}
+		if got.z != n.z {
+			t.Errorf("proto round trip of %q = %v, want %v", s, got.z, n.z)
+		}
+	}
+}
+
+func TestNumericMarshalProtoLayout(t *testing.T) {
+	n, _ := FromString("-1.5")
+	want := []byte{
+		1,
+		0, 0, 0, 0x80,
+		1, 0, 0, 0,
+		0x00, 0x65, 0xcd, 0x1d,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+		0, 0, 0, 0,
+	}
+	if b := n.MarshalProto(); !bytes.Equal(b, want) {
+		t.Errorf("MarshalProto(-1.5) = %x, want %x", b, want)
+	}
+}
+
+func TestNumericUnmarshalProtoInvalid(t *testing.T) {
+	valid := FromInt(3).MarshalProto()
+
+	tests := map[string][]byte{
+		"empty":        {},
+		"version only": {protoVersion},
+		"version 0":    append([]byte{0}, valid[1:]...),
+		"version 2":    append([]byte{2}, valid[1:]...),
+		"short":        valid[:len(valid)-1],
+		"long":         append(append([]byte{}, valid...), 0),
+		"unit range":   append(append([]byte{protoVersion}, make([]byte, binaryLen-4)...), 0x00, 0xca, 0x9a, 0x3b),
+	}
+
+	for name, data := range tests {
+		n := FromInt(7)
+		if err := n.UnmarshalProto(data); !errors.Is(err, ErrInvalidBinaryEncoding) {
+			t.Errorf("%s: UnmarshalProto error = %v, want %v", name, err, ErrInvalidBinaryEncoding)
+		}
+		if n != FromInt(7) {
+			t.Errorf("%s: UnmarshalProto modified value to %s", name, n)
+		}
+	}
+}
@@ -18,3 +18,29 @@ func sanitizeInput(input string) string {
 	// Limit to 10 decimal places
 	return d.Round(10).String()
 }
+
+func sanitizeRoundInput(input string) string {
+	// Normalize input to the whole numeric range with ≤36 decimal digits
+	d, err := decimal.NewFromString(input)
+	if err != nil || d.Exponent() < -100 || d.Exponent() > 100 {
+		// avoid very large rescaling in the reference implementation
+		return "0"
+	}
+	max := decimal.RequireFromString("999999999999999999")
+	min := max.Neg()
+	if d.GreaterThan(max) {
+		d = max
+	} else if d.LessThan(min) {
+		d = min
+	}
+	return d.Truncate(36).String()
+}
+
+// roundHalfDown rounds d to places with halves rounded toward zero, which decimal does not provide.
+func roundHalfDown(d decimal.Decimal, places int32) decimal.Decimal {
+	down := d.RoundDown(places)
+	if d.Sub(down).Abs().Equal(decimal.New(5, -places-1)) {
+		return down
+	}
+	return d.Round(places)
+}
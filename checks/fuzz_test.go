@@ -86,3 +86,58 @@ func FuzzArithmeticConsistency(f *testing.F) {
 		}
 	})
 }
+
+func FuzzRoundConsistency(f *testing.F) {
+	seed := []string{
+		"0", "2.5", "-2.5", "0.5", "1.005", "-1.005", "0.999999999",
+		"0.0000000005", "123.4567895", "-9999999.9999999999",
+		"0.123456789123456789123456789123456789", "999999999999999999.5",
+	}
+	for _, a := range seed {
+		for _, places := range []uint8{0, 1, 2, 8, 9, 10, 17, 18, 19, 27, 35, 36} {
+			f.Add(a, places)
+		}
+	}
+
+	modes := []struct {
+		mode      numeric.RoundMode
+		decimalOp func(d decimal.Decimal, places int32) decimal.Decimal
+	}{
+		{numeric.RoundTowards, decimal.Decimal.RoundDown},
+		{numeric.RoundAway, decimal.Decimal.RoundUp},
+		{numeric.RoundHalfDown, roundHalfDown},
+		{numeric.RoundHalfUp, decimal.Decimal.Round},
+		{numeric.RoundHalfEven, decimal.Decimal.RoundBank},
+		{numeric.RoundCeil, decimal.Decimal.RoundCeil},
+		{numeric.RoundFloor, decimal.Decimal.RoundFloor},
+	}
+
+	f.Fuzz(func(t *testing.T, aStr string, places uint8) {
+		aStr = sanitizeRoundInput(aStr)
+		p := int(places) % 37
+
+		dec, err := decimal.NewFromString(aStr)
+		if err != nil {
+			t.Skipf("invalid decimal input: %q", aStr)
+		}
+		num, err := numeric.FromString(aStr)
+		if err != nil {
+			t.Skipf("invalid numeric input: %q", aStr)
+		}
+
+		for _, m := range modes {
+			decRes := m.decimalOp(dec, int32(p))
+			numRes := num.Round(p, m.mode).String()
+
+			if decRes.Abs().GreaterThan(decimal.RequireFromString("999999999999999999.999999999999999999999999999999999999")) {
+				if !strings.Contains(numRes, "<") {
+					t.Errorf("mode %s: %s rounded to %d places = %s, want overflow", m.mode, aStr, p, numRes)
+				}
+				continue
+			}
+			if numRes != decRes.String() {
+				t.Errorf("mode %s: %s rounded to %d places = %s, want %s", m.mode, aStr, p, numRes, decRes.String())
+			}
+		}
+	})
+}
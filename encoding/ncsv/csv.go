@@ -0,0 +1,63 @@
+// Package ncsv provides helpers to read and write numeric columns with
+// encoding/csv, keeping the core numeric package free of the csv dependency.
+//
+// Values are written using the numeric String format, so NaN, underflow and
+// overflow values are written as "NaN", "~..." and "<..." and parse back
+// unchanged.
+
+package ncsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nehemming/numeric"
+)
+
+var (
+	// ErrInvalidCell is returned when a cell cannot be parsed as a numeric.
+	ErrInvalidCell = errors.New("invalid numeric cell")
+
+	// ErrMissingColumn is returned when a record has no field for the requested column.
+	ErrMissingColumn = errors.New("missing numeric column")
+)
+
+// WriteRecord writes nums as a single csv record, one field per value.
+// As with csv.Writer.Write, the record may be buffered until w is flushed.
+func WriteRecord(w *csv.Writer, nums []numeric.Numeric) error {
+	record := make([]string, len(nums))
+	for i, n := range nums {
+		record[i] = n.String()
+	}
+	return w.Write(record)
+}
+
+// ReadColumn reads the remaining records from r and returns the zero based
+// column col of each parsed as a numeric. A header record should be read
+// before calling ReadColumn. Malformed cells return ErrInvalidCell, and
+// records without the column ErrMissingColumn, with the input line and one
+// based field number of the failing cell.
+func ReadColumn(r *csv.Reader, col int) ([]numeric.Numeric, error) {
+	var nums []numeric.Numeric
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nums, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if col < 0 || col >= len(record) {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("%w: line %d, field %d", ErrMissingColumn, line, col+1)
+		}
+		n, err := numeric.FromString(record[col])
+		if err != nil {
+			line, _ := r.FieldPos(col)
+			return nil, fmt.Errorf("%w: line %d, field %d: %w", ErrInvalidCell, line, col+1, err)
+		}
+		nums = append(nums, n)
+	}
+}
@@ -0,0 +1,110 @@
+package ncsv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nehemming/numeric"
+)
+
+func parseAll(t *testing.T, ss ...string) []numeric.Numeric {
+	t.Helper()
+	nums := make([]numeric.Numeric, len(ss))
+	for i, s := range ss {
+		n, err := numeric.FromString(s)
+		if err != nil {
+			t.Fatalf("FromString(%q) failed: %v", s, err)
+		}
+		nums[i] = n
+	}
+	return nums
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"1.25", "-7", "NaN"},
+		{"0", "~0.333", "<1"},
+		{"-0.000000000000000000000000000000000001", "123456789012345678", "-<1"},
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, row := range rows {
+		if err := WriteRecord(w, parseAll(t, row...)); err != nil {
+			t.Fatalf("WriteRecord(%v) failed: %v", row, err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	for col := range rows[0] {
+		got, err := ReadColumn(csv.NewReader(bytes.NewReader(buf.Bytes())), col)
+		if err != nil {
+			t.Fatalf("ReadColumn(%d) failed: %v", col, err)
+		}
+		if len(got) != len(rows) {
+			t.Fatalf("ReadColumn(%d) returned %d values, want %d", col, len(got), len(rows))
+		}
+		for i, n := range got {
+			want := parseAll(t, rows[i][col])[0]
+			if !n.IsIdentical(want) {
+				t.Errorf("ReadColumn(%d)[%d] = %q, want %q", col, i, n, want)
+			}
+		}
+	}
+}
+
+func TestReadColumnAfterHeader(t *testing.T) {
+	r := csv.NewReader(strings.NewReader("sku,price\na,1.50\nb,2\n"))
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("reading header failed: %v", err)
+	}
+	got, err := ReadColumn(r, 1)
+	if err != nil {
+		t.Fatalf("ReadColumn failed: %v", err)
+	}
+	if len(got) != 2 || got[0].String() != "1.5" || got[1].String() != "2" {
+		t.Errorf("ReadColumn = %v, want [1.5 2]", got)
+	}
+}
+
+func TestReadColumnErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		col   int
+		err   error
+		msg   string
+	}{
+		{"malformed", "1,2\n3,x\n", 1, ErrInvalidCell, "line 2, field 2"},
+		{"malformed first", "1.2.3,2\n", 0, ErrInvalidCell, "line 1, field 1"},
+		{"missing", "1,2\n3,4\n", 2, ErrMissingColumn, "line 1, field 3"},
+		{"negative", "1,2\n", -1, ErrMissingColumn, "line 1, field 0"},
+		{"csv", "1,2\n3\n", 0, csv.ErrFieldCount, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ReadColumn(csv.NewReader(strings.NewReader(tc.input)), tc.col)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("ReadColumn error = %v, want %v", err, tc.err)
+			}
+			if got != nil {
+				t.Errorf("ReadColumn returned %v, want nil", got)
+			}
+			if !strings.Contains(err.Error(), tc.msg) {
+				t.Errorf("ReadColumn error = %q, want it to contain %q", err, tc.msg)
+			}
+		})
+	}
+
+	_, err := ReadColumn(csv.NewReader(strings.NewReader("a\n")), 0)
+	if !errors.Is(err, numeric.ErrParseFormatNumeric) {
+		t.Errorf("ReadColumn error = %v, want ErrParseFormatNumeric", err)
+	}
+}
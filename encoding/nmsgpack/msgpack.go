@@ -0,0 +1,221 @@
+// Package nmsgpack encodes numeric values as msgpack extension values for
+// compact, exact caching.
+//
+// A Numeric is written as an ext value of type ExtType whose payload is a
+// header byte followed by the significant digits as a big-endian integer.
+// The header holds the sign and underflow flags in its top two bits and, in
+// the low six, the number of decimal places the integer is scaled by, 0 to
+// 36, so 123.45 is the integer 12345 with a scale of 2. A NaN has no digits,
+// and overflowed values, along with the rare flag combinations the header
+// cannot hold, carry the 24 byte MarshalBinary state instead, so every value
+// round-trips exactly. Payloads of 1, 2, 4, 8 or 16 bytes use the msgpack
+// fixext formats, the rest ext 8.
+//
+// 123.45 takes 6 bytes against 7 for its msgpack string, and the full
+// precision result of a division about half its string length. Only values
+// of one or two characters, such as 0 or 1, take a byte or two more than
+// their string. The format is simple enough to write directly, so the package
+// has no msgpack library dependency; the bytes can be embedded in a larger
+// message as a raw msgpack value.
+
+package nmsgpack
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/nehemming/numeric"
+)
+
+const (
+	// ExtType is the msgpack application extension type used for Numeric values.
+	ExtType int8 = 'N'
+
+	// ext8 is the msgpack ext 8 format byte, followed by a length and type byte.
+	ext8 = 0xc7
+
+	// fixext1 is the msgpack fixext 1 format byte, followed by a type byte.
+	// The fixext 2, 4, 8 and 16 format bytes follow it in turn.
+	fixext1  = 0xd4
+	fixext16 = 0xd8
+
+	// binaryLen is the length of the MarshalBinary state.
+	binaryLen = 24
+
+	// signBit and underflowBit are the header flags, below them is the scale.
+	signBit      = 0x80
+	underflowBit = 0x40
+	scaleMask    = 0x3f
+
+	// scaleNaN and scaleBinary are header scales marking a NaN, and a payload
+	// holding the MarshalBinary state.
+	scaleNaN    = 62
+	scaleBinary = 63
+
+	// maxScale is the largest scale, the decimal places of a Numeric.
+	maxScale = 36
+
+	// radix is the base of each unit of the MarshalBinary state.
+	radix = 1_000_000_000
+
+	// flagBit is the flag bit of the first four MarshalBinary units: the
+	// sign, NaN, overflow and underflow flags in turn.
+	flagBit = 1 << 31
+)
+
+// ErrInvalidEncoding is returned when data is not a msgpack encoded Numeric.
+var ErrInvalidEncoding = errors.New("invalid msgpack numeric encoding")
+
+// Marshal returns n encoded as a msgpack ext value.
+func Marshal(n numeric.Numeric) ([]byte, error) {
+	state, err := n.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var units [6]uint32
+	isZero := true
+	for i := range units {
+		units[i] = binary.BigEndian.Uint32(state[4*i:])
+		isZero = isZero && units[i]&^flagBit == 0
+	}
+
+	var header byte
+	if units[0]&flagBit != 0 {
+		header |= signBit
+	}
+	if units[3]&flagBit != 0 {
+		header |= underflowBit
+	}
+
+	var payload []byte
+	isNaN, isOverflow := units[1]&flagBit != 0, units[2]&flagBit != 0
+	switch {
+	case isNaN && !isOverflow && isZero:
+		payload = []byte{header | scaleNaN}
+	case isNaN || isOverflow:
+		payload = append([]byte{scaleBinary}, state...)
+	default:
+		payload = appendDigits([]byte{header}, &units)
+	}
+	return appendExt(make([]byte, 0, 3+len(payload)), payload), nil
+}
+
+// appendDigits sets the scale in the header b[0] and appends the significant
+// digits of units, without trailing decimal zeros, as a big-endian integer.
+func appendDigits(b []byte, units *[6]uint32) []byte {
+	last := 1
+	for i := 2; i < len(units); i++ {
+		if units[i]&^flagBit != 0 {
+			last = i
+		}
+	}
+
+	scale := (last - 1) * 9
+	low, lowRadix := units[last]&^flagBit, int64(radix)
+	for last > 1 && low%10 == 0 {
+		low /= 10
+		lowRadix /= 10
+		scale--
+	}
+
+	m := new(big.Int)
+	for _, u := range units[:last] {
+		m.Mul(m, big.NewInt(radix)).Add(m, big.NewInt(int64(u&^flagBit)))
+	}
+	m.Mul(m, big.NewInt(lowRadix)).Add(m, big.NewInt(int64(low)))
+
+	b[0] |= byte(scale)
+	return append(b, m.Bytes()...)
+}
+
+// appendExt appends payload to b as an ExtType msgpack ext value, using a
+// fixext format where the length allows.
+func appendExt(b, payload []byte) []byte {
+	switch l := len(payload); l {
+	case 1, 2, 4, 8, 16:
+		b = append(b, fixext1+byte(bits.TrailingZeros(uint(l))), byte(ExtType))
+	default:
+		b = append(b, ext8, byte(l), byte(ExtType))
+	}
+	return append(b, payload...)
+}
+
+// Unmarshal decodes a msgpack ext value written by Marshal. Data with the
+// wrong format, length, extension type or payload returns ErrInvalidEncoding.
+func Unmarshal(b []byte) (numeric.Numeric, error) {
+	payload, err := extPayload(b)
+	if err != nil {
+		return numeric.Numeric{}, err
+	}
+	header, digits := payload[0], payload[1:]
+
+	var n numeric.Numeric
+	if header == scaleBinary {
+		if err := n.UnmarshalBinary(digits); err != nil {
+			return numeric.Numeric{}, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+		}
+		return n, nil
+	}
+
+	var units [6]uint32
+	switch scale := int(header & scaleMask); {
+	case scale == scaleNaN && len(digits) == 0:
+		units[1] |= flagBit
+	case scale > maxScale:
+		return numeric.Numeric{}, fmt.Errorf("%w: header %#x", ErrInvalidEncoding, header)
+	default:
+		// units holds the value scaled to the 36 decimal places.
+		m := new(big.Int).SetBytes(digits)
+		m.Mul(m, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(maxScale-scale)), nil))
+		r, u := big.NewInt(radix), new(big.Int)
+		for i := len(units) - 1; i >= 0; i-- {
+			m.QuoRem(m, r, u)
+			units[i] = uint32(u.Uint64())
+		}
+		if m.Sign() != 0 {
+			return numeric.Numeric{}, fmt.Errorf("%w: digits out of range", ErrInvalidEncoding)
+		}
+	}
+	if header&signBit != 0 {
+		units[0] |= flagBit
+	}
+	if header&underflowBit != 0 {
+		units[3] |= flagBit
+	}
+
+	state := make([]byte, 0, binaryLen)
+	for _, u := range units {
+		state = binary.BigEndian.AppendUint32(state, u)
+	}
+	if err := n.UnmarshalBinary(state); err != nil {
+		return numeric.Numeric{}, fmt.Errorf("%w: %w", ErrInvalidEncoding, err)
+	}
+	return n, nil
+}
+
+// extPayload returns the payload of the ExtType msgpack ext value b.
+func extPayload(b []byte) ([]byte, error) {
+	var t int8
+	var payload []byte
+	switch {
+	case len(b) >= 2 && b[0] >= fixext1 && b[0] <= fixext16:
+		t, payload = int8(b[1]), b[2:]
+		if len(payload) != 1<<(b[0]-fixext1) {
+			return nil, fmt.Errorf("%w: fixext length %d", ErrInvalidEncoding, len(payload))
+		}
+	case len(b) >= 3 && b[0] == ext8:
+		t, payload = int8(b[2]), b[3:]
+		if len(payload) != int(b[1]) || len(payload) == 0 {
+			return nil, fmt.Errorf("%w: ext 8 length %d", ErrInvalidEncoding, len(payload))
+		}
+	default:
+		return nil, fmt.Errorf("%w: not an ext value", ErrInvalidEncoding)
+	}
+	if t != ExtType {
+		return nil, fmt.Errorf("%w: extension type %d", ErrInvalidEncoding, t)
+	}
+	return payload, nil
+}
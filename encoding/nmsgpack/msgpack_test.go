@@ -0,0 +1,133 @@
+package nmsgpack
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nehemming/numeric"
+)
+
+func TestMarshalRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0", "-0", "1", "-42.5", "123.45", "-0.01", "1000000000", "0.000000000000000000000000000000000001",
+		"123456789012345678.123456789012345678901234567890123456",
+		"-999999999999999999.999999999999999999999999999999999999",
+		"~0.333", "~-0", "~-12.5", "<1", "-<1", "~<1", "NaN",
+	}
+
+	for _, s := range inputs {
+		t.Run(s, func(t *testing.T) {
+			n, err := numeric.FromString(s)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", s, err)
+			}
+			b, err := Marshal(n)
+			if err != nil {
+				t.Fatalf("Marshal(%q) failed: %v", s, err)
+			}
+
+			got, err := Unmarshal(b)
+			if err != nil {
+				t.Fatalf("Unmarshal(%q) failed: %v", s, err)
+			}
+			if !got.IsIdentical(n) {
+				t.Errorf("round trip of %q = %q", s, got)
+			}
+			if got.IsNaN() != n.IsNaN() || got.HasUnderflow() != n.HasUnderflow() || got.HasOverflow() != n.HasOverflow() {
+				t.Errorf("round trip of %q changed flags", s)
+			}
+		})
+	}
+}
+
+// strLen returns the length of s as a msgpack fixstr or str 8 value.
+func strLen(s string) int {
+	if len(s) < 32 {
+		return 1 + len(s)
+	}
+	return 2 + len(s)
+}
+
+func TestMarshalSmallerThanString(t *testing.T) {
+	third := numeric.FromInt(1).Div(numeric.FromInt(3))
+	price := numeric.FromInt(1999).Div(numeric.FromInt(7))
+	tests := []struct {
+		input string
+		hex   string
+	}{
+		{"0", "d44e00"},
+		{"1", "d54e0001"},
+		{"-1", "d54e8001"},
+		{"123.45", "c7034e023039"},
+		{"-0.01", "d54e8201"},
+		{"1999.99", "d64e02030d3f"},
+		{"1000000", "d64e000f4240"},
+		{"~-0", "d44ec0"},
+		{"NaN", "d44e3e"},
+		{third.String(), ""},
+		{price.String(), ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := numeric.FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			b, err := Marshal(n)
+			if err != nil {
+				t.Fatalf("Marshal(%q) failed: %v", tc.input, err)
+			}
+			if got := fmt.Sprintf("%x", b); tc.hex != "" && got != tc.hex {
+				t.Errorf("Marshal(%q) = %s, want %s", tc.input, got, tc.hex)
+			}
+			// only one and two character values are longer than their string.
+			str := strLen(n.String())
+			if len(n.String()) > 2 && len(b) >= str {
+				t.Errorf("Marshal(%q) is %d bytes, want fewer than the %d byte string form", tc.input, len(b), str)
+			}
+			if len(b) > str+2 {
+				t.Errorf("Marshal(%q) is %d bytes, want at most %d", tc.input, len(b), str+2)
+			}
+		})
+	}
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	valid, _ := Marshal(numeric.FromFloat64(123.45))
+	withByte := func(b []byte, i int, v byte) []byte {
+		b = append([]byte{}, b...)
+		b[i] = v
+		return b
+	}
+	overflow, _ := numeric.FromString("<1")
+	over, _ := Marshal(overflow)
+
+	tests := map[string][]byte{
+		"empty":         {},
+		"short":         valid[:len(valid)-1],
+		"long":          append(append([]byte{}, valid...), 0),
+		"format":        withByte(valid, 0, 0xc8),
+		"length":        withByte(valid, 1, 4),
+		"zero length":   {ext8, 0, byte(ExtType)},
+		"fixext length": {fixext1 + 1, byte(ExtType), 0},
+		"type":          withByte(valid, 2, 1),
+		"scale":         withByte(valid, 3, maxScale+1),
+		"NaN digits":    {fixext1 + 1, byte(ExtType), scaleNaN, 1},
+		"digits range":  append([]byte{ext8, 24, byte(ExtType), 0}, make([]byte, 23)...),
+		"binary flags":  withByte(over, 3, scaleBinary|signBit),
+		"binary length": over[:len(over)-1],
+		"binary unit":   withByte(over, len(over)-4, 0xff),
+	}
+	// 10^54 needs 23 bytes, all 0xff is beyond the 54 digits.
+	for i := 4; i < len(tests["digits range"]); i++ {
+		tests["digits range"][i] = 0xff
+	}
+
+	for name, data := range tests {
+		if _, err := Unmarshal(data); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: Unmarshal error = %v, want %v", name, err, ErrInvalidEncoding)
+		}
+	}
+}
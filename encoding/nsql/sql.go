@@ -1,7 +1,7 @@
 // Package nsql provides numeric types that implement the database/sql Scanner and Valuer
 // interfaces, allowing safe and consistent handling of numeric values with SQL databases.
 //
-// The package defines four primary types:
+// The package defines six primary types:
 //
 //   - NumericVal:
 //     A non-null numeric value for NUMERIC/DECIMAL SQL columns. NaN, underflow, and overflow
@@ -18,6 +18,15 @@
 // 	   Supports JSON null marshalling. The Valid field indicates
 //     whether a non-null value is present.
 //
+//   - LenientNumeric:
+//     A NullNumericVal that keeps underflowed values, with their ~ marker, rather than
+//     treating them as SQL NULLs. NaN and overflow are still NULL.
+//
+//   - NumericJSON:
+//     A numeric value stored as a JSON string in a JSON/JSONB column, e.g. "123.45"
+//     including the quotes. NaN, underflow, and overflow are permitted and encoded
+//     using the numeric package’s string format. SQL and JSON nulls are mapped to NaN.
+//
 //   - NullNumericStr:
 //     A nullable numeric-as-string value for TEXT/CHAR columns. Accepts and encodes NaN,
 //     underflow, and overflow using string representation.
@@ -31,9 +40,15 @@
 package nsql
 
 import (
+	"bytes"
+	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/nehemming/numeric"
@@ -45,6 +60,123 @@ var (
 
 	// ErrIsUnderOverNaN is returned when a value cannot be converted to a valid storage type.
 	ErrIsUnderOverNaN = errors.New("cannot convert value (NaN/Overflow/Underflow) to storage type")
+
+	// ErrInvalidJSON is returned when a NumericJSON scans text that is not valid JSON.
+	ErrInvalidJSON = errors.New("invalid JSON numeric")
+)
+
+const (
+	// ValueString returns values from Value as a string. It is the default.
+	ValueString ValueFormat = iota
+
+	// ValueBytes returns values from Value as a []byte.
+	ValueBytes
+)
+
+// ValueFormat selects the driver.Value type returned by the Value methods.
+type ValueFormat int32
+
+// valueFormatString maps ValueFormat values to human-readable strings.
+var valueFormatString = map[ValueFormat]string{
+	ValueString: "string",
+	ValueBytes:  "bytes",
+}
+
+// String returns the string name for the ValueFormat.
+func (vf ValueFormat) String() string {
+	v, ok := valueFormatString[vf]
+	if ok {
+		return v
+	}
+	return ""
+}
+
+// valueFormat is the format returned by the Value methods.
+var valueFormat atomic.Int32
+
+// SetValueFormat selects whether the Value methods return the numeric text as
+// a string (the default) or as a []byte, which some drivers, such as those for
+// Postgres NUMERIC columns, handle more efficiently. It is safe for concurrent use.
+func SetValueFormat(format ValueFormat) {
+	valueFormat.Store(int32(format))
+}
+
+// value returns n in the configured ValueFormat.
+func value(n numeric.Numeric) driver.Value {
+	if ValueFormat(valueFormat.Load()) == ValueBytes {
+		b, _ := n.AppendText(nil)
+		return b
+	}
+	return n.String()
+}
+
+// currencySymbols holds the symbols stripped before parsing scanned strings.
+type currencySymbols struct {
+	prefixes []string
+	suffixes []string
+}
+
+// currency is the configured currency symbols, nil when none are set.
+var currency atomic.Pointer[currencySymbols]
+
+// SetCurrencySymbols configures currency symbols that NumericStr and
+// NullNumericStr strip from scanned text before parsing, e.g. "$" as a prefix
+// so "$12.34" and "-$12.34" scan as 12.34 and -12.34, or " EUR" as a suffix.
+// The first matching prefix and suffix are removed. A symbol without a number
+// returns numeric.ErrNoDigitsInInput. Calling with no symbols restores the
+// default, where symbols are invalid characters. It is safe for concurrent use.
+func SetCurrencySymbols(prefixes, suffixes []string) {
+	if len(prefixes) == 0 && len(suffixes) == 0 {
+		currency.Store(nil)
+		return
+	}
+	currency.Store(&currencySymbols{prefixes: slices.Clone(prefixes), suffixes: slices.Clone(suffixes)})
+}
+
+// parseStr parses scanned text, first stripping any configured currency symbols.
+func parseStr(s string) (numeric.Numeric, error) {
+	cs := currency.Load()
+	if cs == nil {
+		return numeric.FromString(s)
+	}
+
+	t := strings.TrimSpace(s)
+	var sign string
+	if len(t) > 0 && (t[0] == '-' || t[0] == '+') {
+		sign, t = t[:1], t[1:]
+	}
+	var stripped bool
+	for _, p := range cs.prefixes {
+		if p != "" && strings.HasPrefix(t, p) {
+			t, stripped = t[len(p):], true
+			break
+		}
+	}
+	for _, p := range cs.suffixes {
+		if p != "" && strings.HasSuffix(t, p) {
+			t, stripped = t[:len(t)-len(p)], true
+			break
+		}
+	}
+	if !stripped {
+		return numeric.FromString(s)
+	}
+
+	t = strings.TrimSpace(t)
+	if strings.TrimLeft(t, "+-") == "" {
+		return numeric.Numeric{}, fmt.Errorf("%w: %w for %s", numeric.ErrParseFormatNumeric, numeric.ErrNoDigitsInInput, s)
+	}
+	return numeric.FromString(sign + t)
+}
+
+// All wrapper types support generic code constrained on numeric.Arith.
+var (
+	_ numeric.Arith = NumericVal{}
+	_ numeric.Arith = NumericStr{}
+	_ numeric.Arith = NullNumericVal{}
+	_ numeric.Arith = LenientNumeric{}
+	_ numeric.Arith = NullNumericStr{}
+	_ numeric.Arith = NumericJSON{}
 )
 
 type (
@@ -63,11 +195,28 @@ type (
 
 	// NullNumericVal is a numeric value that can be stored in a database Numeric type
 	// The value treats NaN, underflows and overflows as Null.
+	// Use LenientNumeric to keep underflowed values instead.
 	NullNumericVal struct {
 		numeric.Numeric
 		Valid bool
 	}
 
+	// LenientNumeric is a NullNumericVal that keeps underflowed values, so a
+	// column can be scanned leniently while others stay strict. They are
+	// scanned and unmarshalled with their ~ marker and stored as their
+	// approximate value, rather than as Null. NaN and overflow are still Null.
+	LenientNumeric struct {
+		numeric.Numeric
+		Valid bool
+	}
+
+	// NumericJSON is a numeric value that can be stored in a database JSON/JSONB type
+	// as a JSON string, using the Numeric JSON encoding.
+	// A Null value read from the database, or a JSON null, will be mapped to a NaN value.
+	NumericJSON struct {
+		numeric.Numeric
+	}
+
 	// NullNumericStr is a numeric value that can be stored in a database nullable Text/Char type.
 	// Underflows, Overflows and NaN are encoded using the numeric String format.
 	// Null needs to be explicably set.
@@ -96,6 +245,13 @@ func (nv *NumericVal) Scan(value any) error {
 			return err
 		}
 		nv.Numeric = num
+	case sql.RawBytes:
+		// RawBytes is only valid until the next scan, so parse a copy.
+		num, err := numeric.FromString(string(v))
+		if err != nil {
+			return err
+		}
+		nv.Numeric = num
 	case string:
 		num, err := numeric.FromString(v)
 		if err != nil {
@@ -113,7 +269,7 @@ func (nv NumericVal) Value() (driver.Value, error) {
 	if nv.IsUnderOverNaN() {
 		return nil, ErrIsUnderOverNaN
 	}
-	return nv.String(), nil
+	return value(nv.Numeric), nil
 }
 
 func (ns *NumericStr) Scan(value any) error {
@@ -132,13 +288,20 @@ func (ns *NumericStr) Scan(value any) error {
 		ns.Numeric = numeric.FromFloat64(v)
 	case []byte:
 		s := unsafe.String(unsafe.SliceData(v), len(v))
-		num, err := numeric.FromString(s)
+		num, err := parseStr(s)
+		if err != nil {
+			return err
+		}
+		ns.Numeric = num
+	case sql.RawBytes:
+		// RawBytes is only valid until the next scan, so parse a copy.
+		num, err := parseStr(string(v))
 		if err != nil {
 			return err
 		}
 		ns.Numeric = num
 	case string:
-		num, err := numeric.FromString(v)
+		num, err := parseStr(v)
 		if err != nil {
 			return err
 		}
@@ -150,10 +313,54 @@ func (ns *NumericStr) Scan(value any) error {
 }
 
 func (ns NumericStr) Value() (driver.Value, error) {
-	return ns.String(), nil
+	return value(ns.Numeric), nil
+}
+
+func (nj *NumericJSON) Scan(value any) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		nj.Numeric = numeric.NaN()
+		return nil
+	case []byte:
+		data = v
+	case sql.RawBytes:
+		// RawBytes is only valid until the next scan, so parse a copy.
+		data = slices.Clone(v)
+	case string:
+		data = unsafe.Slice(unsafe.StringData(v), len(v))
+	default:
+		return fmt.Errorf("%w: %T into NumericJSON", ErrCannotCoerceScannedType, value)
+	}
+
+	if !json.Valid(data) {
+		return fmt.Errorf("%w: %s", ErrInvalidJSON, data)
+	}
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		nj.Numeric = numeric.NaN()
+		return nil
+	}
+	return nj.Numeric.UnmarshalJSON(data)
+}
+
+func (nj NumericJSON) Value() (driver.Value, error) {
+	b, err := nj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if ValueFormat(valueFormat.Load()) == ValueBytes {
+		return b, nil
+	}
+	return string(b), nil
 }
 
 func (nv *NullNumericVal) Scan(value any) error {
+	return nv.scan(value, false)
+}
+
+// scan implements Scan, keeping underflowed values if keepUnderflow is set.
+func (nv *NullNumericVal) scan(value any, keepUnderflow bool) error {
 	if value == nil {
 		nv.Numeric = numeric.NaN()
 		nv.Valid = false
@@ -179,6 +386,13 @@ func (nv *NullNumericVal) Scan(value any) error {
 			return err
 		}
 		num = n
+	case sql.RawBytes:
+		// RawBytes is only valid until the next scan, so parse a copy.
+		n, err := numeric.FromString(string(v))
+		if err != nil {
+			return err
+		}
+		num = n
 	case string:
 		n, err := numeric.FromString(v)
 		if err != nil {
@@ -189,7 +403,7 @@ func (nv *NullNumericVal) Scan(value any) error {
 		return fmt.Errorf("%w: %T into NullNumericVal", ErrCannotCoerceScannedType, value)
 	}
 
-	if num.IsUnderOverNaN() {
+	if isNull(num, keepUnderflow) {
 		nv.Numeric = numeric.NaN()
 		nv.Valid = false
 	} else {
@@ -200,10 +414,36 @@ func (nv *NullNumericVal) Scan(value any) error {
 }
 
 func (nv NullNumericVal) Value() (driver.Value, error) {
-	if !nv.Valid || nv.IsNaN() || nv.HasOverflow() || nv.HasUnderflow() {
+	return nv.value(false)
+}
+
+// value implements Value, storing underflowed values if keepUnderflow is set.
+func (nv NullNumericVal) value(keepUnderflow bool) (driver.Value, error) {
+	if !nv.Valid || isNull(nv.Numeric, keepUnderflow) {
 		return nil, nil
 	}
-	return nv.String(), nil
+	// the column cannot hold the ~ marker, so store the approximate value.
+	return value(nv.TruncateTo(numeric.MaxDecimalPlaces)), nil
+}
+
+func (ln *LenientNumeric) Scan(value any) error {
+	nv := NullNumericVal(*ln)
+	err := nv.scan(value, true)
+	*ln = LenientNumeric(nv)
+	return err
+}
+
+func (ln LenientNumeric) Value() (driver.Value, error) {
+	return NullNumericVal(ln).value(true)
+}
+
+// isNull returns true if n is stored as Null, keeping underflowed values if
+// keepUnderflow is set.
+func isNull(n numeric.Numeric, keepUnderflow bool) bool {
+	if keepUnderflow {
+		return n.IsNaN() || n.HasOverflow()
+	}
+	return n.IsUnderOverNaN()
 }
 
 func (ns *NullNumericStr) Scan(value any) error {
@@ -229,13 +469,20 @@ func (ns *NullNumericStr) Scan(value any) error {
 		}
 	case []byte:
 		s := unsafe.String(unsafe.SliceData(v), len(v))
-		n, err := numeric.FromString(s)
+		n, err := parseStr(s)
+		if err != nil {
+			return err
+		}
+		num = n
+	case sql.RawBytes:
+		// RawBytes is only valid until the next scan, so parse a copy.
+		n, err := parseStr(string(v))
 		if err != nil {
 			return err
 		}
 		num = n
 	case string:
-		n, err := numeric.FromString(v)
+		n, err := parseStr(v)
 		if err != nil {
 			return err
 		}
@@ -253,7 +500,7 @@ func (ns NullNumericStr) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return ns.String(), nil
+	return value(ns.Numeric), nil
 }
 
 func (nv NullNumericVal) String() string {
@@ -263,6 +510,10 @@ func (nv NullNumericVal) String() string {
 	return nv.Numeric.String()
 }
 
+func (ln LenientNumeric) String() string {
+	return NullNumericVal(ln).String()
+}
+
 func (ns NullNumericStr) String() string {
 	if !ns.Valid {
 		return "null"
@@ -279,6 +530,11 @@ func (n NullNumericVal) Format(f fmt.State, verb rune) {
 	n.Numeric.Format(f, verb)
 }
 
+// Format implements string formatting for LenientNumeric.
+func (n LenientNumeric) Format(f fmt.State, verb rune) {
+	NullNumericVal(n).Format(f, verb)
+}
+
 // Format implements string formatting for NullNumericStr.
 func (n NullNumericStr) Format(f fmt.State, verb rune) {
 	if !n.Valid {
@@ -298,6 +554,12 @@ func (n NullNumericVal) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON for NullNumericVal
 func (n *NullNumericVal) UnmarshalJSON(data []byte) error {
+	return n.unmarshalJSON(data, false)
+}
+
+// unmarshalJSON implements UnmarshalJSON, keeping underflowed values if
+// keepUnderflow is set.
+func (n *NullNumericVal) unmarshalJSON(data []byte, keepUnderflow bool) error {
 	if string(data) == "null" {
 		n.Numeric = numeric.NaN()
 		n.Valid = false
@@ -306,10 +568,23 @@ func (n *NullNumericVal) UnmarshalJSON(data []byte) error {
 	if err := n.Numeric.UnmarshalJSON(data); err != nil {
 		return err
 	}
-	n.Valid = !n.Numeric.IsUnderOverNaN()
+	n.Valid = !isNull(n.Numeric, keepUnderflow)
 	return nil
 }
 
+// MarshalJSON for LenientNumeric
+func (n LenientNumeric) MarshalJSON() ([]byte, error) {
+	return NullNumericVal(n).MarshalJSON()
+}
+
+// UnmarshalJSON for LenientNumeric
+func (n *LenientNumeric) UnmarshalJSON(data []byte) error {
+	nv := NullNumericVal(*n)
+	err := nv.unmarshalJSON(data, true)
+	*n = LenientNumeric(nv)
+	return err
+}
+
 // MarshalJSON for NullNumericStr
 func (n NullNumericStr) MarshalJSON() ([]byte, error) {
 	if !n.Valid {
@@ -1,6 +1,8 @@
 package nsql
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -401,3 +403,317 @@ func TestNullNumericStr_JSONRoundTrip(t *testing.T) {
 		}
 	}
 }
+
+func sumArith[T numeric.Arith](xs []T) numeric.Numeric {
+	var total numeric.Numeric
+	for _, x := range xs {
+		total = x.Add(total)
+	}
+	return total
+}
+
+func TestArithWrappers(t *testing.T) {
+	one, two := numeric.FromInt(1), numeric.FromInt(2)
+
+	tests := []struct {
+		name string
+		got  numeric.Numeric
+	}{
+		{"NumericVal", sumArith([]NumericVal{{one}, {two}})},
+		{"NumericStr", sumArith([]NumericStr{{one}, {two}})},
+		{"NullNumericVal", sumArith([]NullNumericVal{{one, true}, {two, true}})},
+		{"LenientNumeric", sumArith([]LenientNumeric{{one, true}, {two, true}})},
+		{"NullNumericStr", sumArith([]NullNumericStr{{one, true}, {two, true}})},
+	}
+
+	for _, tt := range tests {
+		if tt.got.String() != "3" {
+			t.Errorf("%s: sum = %s, want 3", tt.name, tt.got)
+		}
+	}
+}
+
+func TestScanRawBytes(t *testing.T) {
+	scanners := map[string]interface {
+		Scan(any) error
+		String() string
+	}{
+		"NumericVal":     &NumericVal{},
+		"NumericStr":     &NumericStr{},
+		"NullNumericVal": &NullNumericVal{},
+		"LenientNumeric": &LenientNumeric{},
+		"NullNumericStr": &NullNumericStr{},
+	}
+
+	for name, s := range scanners {
+		t.Run(name, func(t *testing.T) {
+			raw := sql.RawBytes("123.456")
+			if err := s.Scan(raw); err != nil {
+				t.Fatalf("Scan(RawBytes) failed: %v", err)
+			}
+			// the driver reuses the buffer on the next scan.
+			copy(raw, "999.999")
+			if got := s.String(); got != "123.456" {
+				t.Errorf("Scan(RawBytes) = %q, want 123.456", got)
+			}
+
+			if err := s.Scan([]byte("-7.5")); err != nil {
+				t.Fatalf("Scan([]byte) failed: %v", err)
+			}
+			if got := s.String(); got != "-7.5" {
+				t.Errorf("Scan([]byte) = %q, want -7.5", got)
+			}
+
+			err := s.Scan(sql.RawBytes("1.2.3"))
+			if !errors.Is(err, numeric.ErrParseFormatNumeric) {
+				t.Errorf("Scan(RawBytes(1.2.3)) error = %v, want %v", err, numeric.ErrParseFormatNumeric)
+			}
+		})
+	}
+}
+
+func TestScanCurrencySymbols(t *testing.T) {
+	// without configuration symbols are invalid characters.
+	var ns NumericStr
+	if err := ns.Scan("$12.34"); !errors.Is(err, numeric.ErrInvalidCharacter) {
+		t.Fatalf("unconfigured Scan($12.34) error = %v, want %v", err, numeric.ErrInvalidCharacter)
+	}
+
+	SetCurrencySymbols([]string{"$", "US$"}, []string{" EUR", "€"})
+	t.Cleanup(func() { SetCurrencySymbols(nil, nil) })
+
+	tests := []struct {
+		input    any
+		expected string
+		err      error
+	}{
+		{"$12.34", "12.34", nil},
+		{"-$12.34", "-12.34", nil},
+		{"$-12.34", "-12.34", nil},
+		{" $ 12.34 ", "12.34", nil},
+		{"US$5", "5", nil},
+		{"12.50 EUR", "12.5", nil},
+		{"-3€", "-3", nil},
+		{[]byte("$7"), "7", nil},
+		{sql.RawBytes("$8"), "8", nil},
+		{"12.34", "12.34", nil},
+		{"NaN", "NaN", nil},
+		{"$", "", numeric.ErrNoDigitsInInput},
+		{"-$", "", numeric.ErrNoDigitsInInput},
+		{"€", "", numeric.ErrNoDigitsInInput},
+		{"$$12", "", numeric.ErrInvalidCharacter},
+		{"£12", "", numeric.ErrInvalidCharacter},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprint(tc.input), func(t *testing.T) {
+			var ns NumericStr
+			var nns NullNumericStr
+			for name, s := range map[string]interface {
+				Scan(any) error
+				String() string
+			}{"NumericStr": &ns, "NullNumericStr": &nns} {
+				err := s.Scan(tc.input)
+				if tc.err != nil {
+					if !errors.Is(err, tc.err) {
+						t.Errorf("%s.Scan(%v) error = %v, want %v", name, tc.input, err, tc.err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s.Scan(%v) failed: %v", name, tc.input, err)
+				}
+				if got := s.String(); got != tc.expected {
+					t.Errorf("%s.Scan(%v) = %q, want %q", name, tc.input, got, tc.expected)
+				}
+			}
+		})
+	}
+
+	// NumericVal columns are unaffected.
+	var nv NumericVal
+	if err := nv.Scan("$12.34"); !errors.Is(err, numeric.ErrInvalidCharacter) {
+		t.Errorf("NumericVal.Scan($12.34) error = %v, want %v", err, numeric.ErrInvalidCharacter)
+	}
+}
+
+func TestSetValueFormat(t *testing.T) {
+	t.Cleanup(func() { SetValueFormat(ValueString) })
+
+	n, _ := numeric.FromString("-123.456")
+	valuers := map[string]driver.Valuer{
+		"NumericVal":     NumericVal{n},
+		"NumericStr":     NumericStr{n},
+		"NullNumericVal": NullNumericVal{n, true},
+		"LenientNumeric": LenientNumeric{n, true},
+		"NullNumericStr": NullNumericStr{n, true},
+	}
+
+	for name, v := range valuers {
+		SetValueFormat(ValueString)
+		s, err := v.Value()
+		if err != nil {
+			t.Fatalf("%s string Value failed: %v", name, err)
+		}
+		if _, ok := s.(string); !ok {
+			t.Errorf("%s string Value = %T, want string", name, s)
+		}
+
+		SetValueFormat(ValueBytes)
+		b, err := v.Value()
+		if err != nil {
+			t.Fatalf("%s bytes Value failed: %v", name, err)
+		}
+		raw, ok := b.([]byte)
+		if !ok {
+			t.Fatalf("%s bytes Value = %T, want []byte", name, b)
+		}
+		if string(raw) != s {
+			t.Errorf("%s bytes Value = %q, want %q", name, raw, s)
+		}
+
+		var back NumericVal
+		if err := back.Scan(raw); err != nil || !back.IsEqual(n) {
+			t.Errorf("%s bytes Value scanned back as %v, %v, want %s", name, back, err, n)
+		}
+	}
+
+	// exceptional values still error or map to NULL in either format.
+	for _, n := range []numeric.Numeric{numeric.NaN(), numeric.MaxValue().Add(numeric.FromInt(1))} {
+		if _, err := (NumericVal{n}).Value(); !errors.Is(err, ErrIsUnderOverNaN) {
+			t.Errorf("NumericVal{%s}.Value() error = %v, want %v", n, err, ErrIsUnderOverNaN)
+		}
+		if v, err := (NullNumericVal{n, true}).Value(); v != nil || err != nil {
+			t.Errorf("NullNumericVal{%s}.Value() = %v, %v, want nil", n, v, err)
+		}
+	}
+
+	if ValueString.String() != "string" || ValueBytes.String() != "bytes" || ValueFormat(9).String() != "" {
+		t.Error("unexpected ValueFormat names")
+	}
+}
+
+func TestLenientNumeric(t *testing.T) {
+	tests := []struct {
+		input       any
+		valid       bool
+		strictValid bool
+		wantStr     string
+		wantValue   driver.Value
+	}{
+		{"1e-40", true, false, "~0", "0"},
+		{"~1.5", true, false, "~1.5", "1.5"},
+		{"-0.0000000000000000000000000000000000015", true, false, "~-0.000000000000000000000000000000000001", "-0.000000000000000000000000000000000001"},
+		{"2.5", true, true, "2.5", "2.5"},
+		{"NaN", false, false, "null", nil},
+		{nil, false, false, "null", nil},
+		{"<1", false, false, "null", nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprint(tc.input), func(t *testing.T) {
+			var ln LenientNumeric
+			if err := ln.Scan(tc.input); err != nil {
+				t.Fatalf("Scan(%v) failed: %v", tc.input, err)
+			}
+			if ln.Valid != tc.valid {
+				t.Errorf("Scan(%v) Valid = %t, want %t", tc.input, ln.Valid, tc.valid)
+			}
+			if got := ln.String(); got != tc.wantStr {
+				t.Errorf("Scan(%v) = %q, want %q", tc.input, got, tc.wantStr)
+			}
+			if got := fmt.Sprintf("%v", ln); got != tc.wantStr {
+				t.Errorf("Sprintf(%%v) = %q, want %q", got, tc.wantStr)
+			}
+			v, err := ln.Value()
+			if err != nil {
+				t.Fatalf("Value() failed: %v", err)
+			}
+			if v != tc.wantValue {
+				t.Errorf("Value() = %#v, want %#v", v, tc.wantValue)
+			}
+
+			// NullNumericVal still maps underflow to Null.
+			var nv NullNumericVal
+			if err := nv.Scan(tc.input); err != nil {
+				t.Fatalf("NullNumericVal Scan(%v) failed: %v", tc.input, err)
+			}
+			if nv.Valid != tc.strictValid {
+				t.Errorf("NullNumericVal Scan(%v) Valid = %t, want %t", tc.input, nv.Valid, tc.strictValid)
+			}
+		})
+	}
+
+	// JSON follows the same rule, per value.
+	var row struct {
+		Strict  NullNumericVal
+		Lenient LenientNumeric
+	}
+	if err := json.Unmarshal([]byte(`{"Strict":"~0.5","Lenient":"~0.5"}`), &row); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if row.Strict.Valid || !row.Lenient.Valid || row.Lenient.String() != "~0.5" {
+		t.Errorf("Unmarshal(~0.5) = %v, %v, want null, ~0.5", row.Strict, row.Lenient)
+	}
+	if b, err := json.Marshal(row); err != nil || string(b) != `{"Strict":null,"Lenient":"~0.5"}` {
+		t.Errorf("Marshal = %s, %v", b, err)
+	}
+}
+
+func TestNumericJSON_ScanAndValue(t *testing.T) {
+	tests := []struct {
+		input     any
+		wantErr   error
+		wantStr   string
+		wantValue string
+	}{
+		{`"123.45"`, nil, "123.45", `"123.45"`},
+		{[]byte(`"-0.5"`), nil, "-0.5", `"-0.5"`},
+		{sql.RawBytes(`"7"`), nil, "7", `"7"`},
+		{` "1.25" `, nil, "1.25", `"1.25"`},
+		{`42`, nil, "42", `"42"`},
+		{`"~0.333"`, nil, "~0.333", `"~0.333"`},
+		{`"<1"`, nil, "<999999999999999999.999999999999999999999999999999999999", `"<999999999999999999.999999999999999999999999999999999999"`},
+		{`"NaN"`, nil, "NaN", `"NaN"`},
+		{`null`, nil, "NaN", `"NaN"`},
+		{nil, nil, "NaN", `"NaN"`},
+		{`"123.45`, ErrInvalidJSON, "", ""},
+		{`123.45"`, ErrInvalidJSON, "", ""},
+		{`NaN`, ErrInvalidJSON, "", ""},
+		{``, ErrInvalidJSON, "", ""},
+		{`"abc"`, numeric.ErrInvalidCharacter, "", ""},
+		{`true`, numeric.ErrParseFormatNumeric, "", ""},
+		{int64(5), ErrCannotCoerceScannedType, "", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprint(tc.input), func(t *testing.T) {
+			var nj NumericJSON
+			err := nj.Scan(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("Scan(%v) error = %v, want %v", tc.input, err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan(%v) failed: %v", tc.input, err)
+			}
+			if got := nj.String(); got != tc.wantStr {
+				t.Errorf("Scan(%v) = %q, want %q", tc.input, got, tc.wantStr)
+			}
+			v, err := nj.Value()
+			if err != nil {
+				t.Fatalf("Value() failed: %v", err)
+			}
+			if v != tc.wantValue {
+				t.Errorf("Value() = %v, want %v", v, tc.wantValue)
+			}
+
+			var back NumericJSON
+			if err := back.Scan(v); err != nil || !back.IsIdentical(nj.Numeric) {
+				t.Errorf("Value() scanned back as %v, %v", back, err)
+			}
+		})
+	}
+}
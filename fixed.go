@@ -1,7 +1,6 @@
 package numeric
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -45,6 +44,7 @@ type (
 		isNaN       bool             // isNaN indicates if the number is NaN (not a number).
 		isOverflow  bool             // isOverflow indicates if the number is too large to represent.
 		isUnderflow bool             // isUnderflow indicates if the number is too small to represent.
+		isExp       bool             // isExp indicates if the parsed input used an exponent.
 	}
 )
 
@@ -82,8 +82,41 @@ var (
 	// ErrInvalidCharacter is returned when an invalid character is encountered in the input string.
 	ErrInvalidCharacter = errors.New("invalid character")
 
+	// ErrNotAnInteger is returned when an integer-only input contains a decimal point or exponent.
+	ErrNotAnInteger = errors.New("decimal point or exponent in integer")
+
+	// ErrInvalidGrouping is returned when spacing in a leniently parsed input is not a valid digit grouping.
+	ErrInvalidGrouping = errors.New("invalid digit grouping")
+
+	// ErrMultiplePercentSigns is returned when a percentage contains more than one '%' sign.
+	ErrMultiplePercentSigns = errors.New("multiple percent signs (%) not allowed")
+
+	// ErrValueOutOfRange is returned by strict parsing when a value overflows or underflows the Numeric range.
+	ErrValueOutOfRange = errors.New("value out of range for Numeric representation")
+
+	// ErrOverflow is returned by validation when a value is too large for the Numeric range.
+	ErrOverflow = errors.New("value overflows Numeric range")
+
+	// ErrUnderflow is returned by validation when a value has more decimal places than a Numeric holds.
+	ErrUnderflow = errors.New("value underflows Numeric range")
+
+	// ErrInvalidPlaces is returned when a number of decimal places is outside the range 0 to 36.
+	ErrInvalidPlaces = errors.New("decimal places out of range")
+
+	// ErrInvalidRate is returned when an exchange rate is not a positive value.
+	ErrInvalidRate = errors.New("rate must be a positive value")
+
+	// ErrDivideByZero is returned by checked division when the divisor is zero.
+	ErrDivideByZero = errors.New("division by zero")
+
+	// ErrNotANumber is returned by checked operations when an operand is NaN.
+	ErrNotANumber = errors.New("operand is not a number")
+
+	// ErrIntegerOutOfRange is returned when an integer's magnitude exceeds 999999999999999999.
 	ErrIntegerOutOfRange = errors.New("integer value out of range for Numeric representation")
-	ErrFloatOutOfRange   = errors.New("float value out of range for Numeric representation")
+
+	// ErrFloatOutOfRange is returned when a float's magnitude is too large for a Numeric.
+	ErrFloatOutOfRange = errors.New("float value out of range for Numeric representation")
 )
 
 var maxF24 = f24{
@@ -104,7 +137,7 @@ func f24Int(v int64) f24 {
 		u = uint64(v)
 	}
 
-	if u >= maxValue {
+	if u > maxValue {
 		return overflow(isNeg)
 	}
 	f[0].setVal(uint32(u / radix))
@@ -365,117 +398,46 @@ func (d *digits) Float64() float64 {
 	}
 }
 
-// output formats the digits into a byte slice.
-func (d *digits) output(buf []byte) []byte {
-	b := bytes.NewBuffer(buf) // Wrap existing slice; reuse memory
-
-	if d.isUnderflow {
-		b.WriteByte('~')
-	}
-	if d.isNeg {
-		b.WriteByte('-')
-	}
-	if d.isOverflow {
-		b.WriteByte('<')
-	}
-	if d.isNaN {
-		b.WriteString("NaN")
-		return b.Bytes()
-	}
-	if d.count == 0 {
-		b.WriteByte('0')
-		return b.Bytes()
-	}
-	if d.pointIdx == 0 {
-		b.WriteByte('0')
-	} else {
-		for _, v := range d.v[:d.pointIdx] {
-			b.WriteByte('0' + v)
+// Float32 converts the digits to the nearest float32, parsing every digit so
+// the result is rounded once.
+func (d *digits) Float32() float32 {
+	switch {
+	case d.isNaN:
+		return float32(math.NaN())
+	case d.isOverflow:
+		if d.isNeg {
+			return float32(math.Inf(-1))
 		}
+		return float32(math.Inf(1))
 	}
 
-	if d.count-d.pointIdx > 0 {
-		var dotted bool
-		var dp int
-		var zeros int
-		for _, v := range d.v[d.pointIdx:d.count] {
-			if dp == maxDecimalPlaces {
-				break
-			}
-			dp++
-			if v == 0 {
-				zeros++
-				continue
-			}
-			if !dotted {
-				b.WriteByte('.')
-				dotted = true
-			}
-			for i := 0; i < zeros; i++ {
-				b.WriteByte('0')
-			}
-			zeros = 0
-			b.WriteByte('0' + v)
-		}
+	var buf [maxStringLen]byte
+	v := *d
+	v.isUnderflow = false
+	b := v.appendString(buf[:0])
+	f, err := strconv.ParseFloat(unsafe.String(&b[0], len(b)), 32)
+	if err != nil {
+		return float32(math.NaN())
 	}
-	return b.Bytes()
+	return float32(f)
+}
+
+// signed reports whether text output shows a minus sign. A negative zero is
+// shown as 0 unless it marks an underflow or overflow.
+func (d *digits) signed() bool {
+	return d.isNeg && (d.count > 0 || d.isUnderflow || d.isOverflow)
+}
+
+// output formats the digits into a byte slice.
+func (d *digits) output(buf []byte) []byte {
+	return d.appendString(buf)
 }
 
 // String formats the digits into a string representation.
 // This function allocates the result to the heap.
 func (d *digits) String() string {
-	var sb strings.Builder
-
-	if d.isUnderflow {
-		sb.WriteRune('~')
-	}
-	if d.isNeg {
-		sb.WriteRune('-')
-	}
-	if d.isOverflow {
-		sb.WriteRune('<')
-	}
-	if d.isNaN {
-		return "NaN"
-	}
-	if d.count == 0 {
-		sb.WriteRune('0')
-		return sb.String()
-	}
-	if d.pointIdx == 0 {
-		sb.WriteRune('0')
-	} else {
-		for _, v := range d.v[:d.pointIdx] {
-			sb.WriteByte('0' + v)
-		}
-	}
-
-	if d.count-d.pointIdx > 0 {
-		var dotted bool
-		var dp int
-		var zeros int
-		for _, v := range d.v[d.pointIdx:d.count] {
-			if dp == maxDecimalPlaces {
-				break
-			}
-			dp++
-			if v == 0 {
-				zeros++
-				continue
-			}
-			if !dotted {
-				sb.WriteByte('.')
-				dotted = true
-			}
-			for range zeros {
-				sb.WriteByte('0')
-			}
-			zeros = 0
-			sb.WriteByte('0' + v)
-		}
-	}
-
-	return sb.String()
+	var buf [maxStringLen]byte
+	return string(d.appendString(buf[:0]))
 }
 
 func (d *digits) parsePrefix(s string) (string, error) {
@@ -592,6 +554,7 @@ func (d *digits) parseString(s string) error {
 	if expSeen && !hasExp {
 		return ErrNoExponentValue
 	}
+	d.isExp = expSeen
 	if !sawDigit {
 		return ErrNoDigitsInInput
 	}
@@ -656,6 +619,52 @@ func (d *digits) scale(pointIdx, count, expVal, expSign int) {
 	d.pointIdx = pointIdx
 }
 
+// shift moves the decimal point n places to the right, or left for a
+// negative n, repositioning the significant digits. Shifting beyond the
+// whole digits sets overflow, and past the decimal places sets underflow.
+func (d *digits) shift(n int) {
+	if d.isNaN || d.isOverflow {
+		return
+	}
+	// beyond twice the digit capacity every shift overflows or underflows,
+	// so clamp n to keep the place arithmetic from wrapping.
+	n = min(max(n, -2*precision), 2*precision)
+
+	// the significant digits are the window between the first and last non zero digits.
+	first, last := -1, 0
+	for i, v := range d.v[:d.count] {
+		if v != 0 {
+			if first < 0 {
+				first = i
+			}
+			last = i + 1
+		}
+	}
+	if first < 0 {
+		return
+	}
+
+	// pointIdx is relative to the first significant digit, negative for leading decimal zeros.
+	pointIdx := d.pointIdx - first + n
+	if pointIdx > maxWholeDigits {
+		d.isOverflow = true
+		return
+	}
+
+	lead := max(-pointIdx, 0)
+	count := lead + last - first
+	var v [precision]uint8
+	if lead < precision {
+		copy(v[lead:], d.v[first:last])
+	}
+	d.v = v
+	d.pointIdx = max(pointIdx, 0)
+	if count-d.pointIdx > maxDecimalPlaces {
+		d.isUnderflow = true
+	}
+	d.count = max(min(count, precision), d.pointIdx)
+}
+
 func (d *digits) setOverflow() {
 	d.isOverflow = true
 	d.pointIdx = maxWholeDigits
@@ -677,23 +686,31 @@ func overflow(isNeg bool) f24 {
 // parseString op level parser function.
 func parseString(s string) (digits, error) {
 	var d digits
+	if err := d.parse(s); err != nil {
+		return digits{}, err
+	}
+	return d, nil
+}
 
+// parse parses s into d, which must be zero, so a single digits can be
+// reused as scratch across many strings.
+func (d *digits) parse(s string) error {
 	original := s
 	s, err := d.parsePrefix(strings.TrimSpace(s))
 	if err != nil {
-		return digits{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, original)
+		return fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, original)
 	}
 	if d.isNaN {
-		return d, nil
+		return nil
 	}
 
 	if err := d.parseString(s); err != nil {
-		return digits{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, original)
+		return fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, original)
 	}
 
 	if d.isOverflow {
 		d.setOverflow()
 	}
 
-	return d, nil
+	return nil
 }
@@ -209,6 +209,8 @@ func TestF24Int(t *testing.T) {
 		{1e9, 1, 0, false, false},
 		{1e9 + 1, 1, 1, false, false},
 		{-1e9, 1, 0, true, false},
+		{int64(maxValue), 999999999, 999999999, false, false}, // largest value
+		{-int64(maxValue), 999999999, 999999999, true, false},
 		{int64(maxValue + 1), 0, 0, false, true}, // triggers overflow
 		{-int64(maxValue + 1), 0, 0, true, true}, // negative overflow
 	}
@@ -0,0 +1,270 @@
+package numeric
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrBufferTooSmall is returned when a destination buffer cannot hold the formatted value.
+var ErrBufferTooSmall = errors.New("buffer too small for numeric value")
+
+// maxStringLen is the longest String representation: markers, whole digits,
+// decimal point and decimal places.
+const maxStringLen = 3 + maxWholeDigits + 1 + maxDecimalPlaces
+
+const (
+	// GroupWestern groups whole digits in threes, e.g. 1,234,567.
+	GroupWestern GroupStyle = iota
+
+	// GroupIndian groups the lowest three whole digits then pairs, e.g. 12,34,567.
+	GroupIndian
+)
+
+// GroupStyle selects how whole digits are grouped when formatting with separators.
+type GroupStyle int
+
+// groupStyleString maps GroupStyle values to human-readable strings.
+var groupStyleString = map[GroupStyle]string{
+	GroupWestern: "western",
+	GroupIndian:  "indian",
+}
+
+// String returns the string name for the GroupStyle.
+func (gs GroupStyle) String() string {
+	v, ok := groupStyleString[gs]
+	if ok {
+		return v
+	}
+	return ""
+}
+
+// separatorBefore returns true if a group separator is placed before a digit
+// that has remaining whole digits (itself included) up to the decimal point.
+func (gs GroupStyle) separatorBefore(remaining int) bool {
+	switch gs {
+	case GroupIndian:
+		return remaining == 3 || (remaining > 3 && (remaining-3)%2 == 0)
+	default:
+		return remaining%3 == 0
+	}
+}
+
+// StringGrouped returns the decimal string representation of the number using
+// sep between groups of three whole digits and decSep as the decimal point.
+func (n Numeric) StringGrouped(sep, decSep rune) string {
+	return n.StringGroupedStyle(GroupWestern, sep, decSep)
+}
+
+// StringGroupedStyle returns the decimal string representation of the number
+// with whole digits grouped according to style, using sep between groups and
+// decSep as the decimal point. Underflow, overflow and NaN markers are the same
+// as for String.
+func (n Numeric) StringGroupedStyle(style GroupStyle, sep, decSep rune) string {
+	var buf [2 * precision]byte
+	d := n.z.Digits()
+	return string(d.appendGrouped(buf[:0], style, sep, decSep))
+}
+
+// Percent returns n multiplied by 100 and formatted with a trailing '%',
+// e.g. 0.125 is "12.5%". NaN returns "NaN".
+func (n Numeric) Percent() string {
+	if n.IsNaN() {
+		return "NaN"
+	}
+	var z f24
+	arith.mul(&z, &n.z, &hundred)
+	return Numeric{z: z}.String() + "%"
+}
+
+// StringTruncated returns the String form of n if it is at most maxLen
+// characters long. Longer values are shown in scientific form, a mantissa with
+// one whole digit cut to fit, followed by '…' and the exponent, e.g.
+// 123456789.123456789 with a maxLen of 9 is "1.2345…e8", so the magnitude stays
+// visible in bounded output. The '…' is left out when no digits are cut, so
+// 100000000000 with a maxLen of 5 is "1e11". The markers, sign and first digit
+// are always kept, even if that exceeds maxLen, and overflow keeps its '<'
+// marker as in String, e.g. "<9.99…e17". NaN returns "NaN".
+func (n Numeric) StringTruncated(maxLen int) string {
+	s := n.String()
+	if n.z.isNaN() || len(s) <= maxLen {
+		return s
+	}
+
+	// split the markers and sign from the digits.
+	i := strings.IndexAny(s, "0123456789")
+	prefix := s[:i]
+	whole, frac, _ := strings.Cut(s[i:], ".")
+
+	// sig holds the significant digits, the first at 10^exp.
+	var exp int
+	var sig string
+	if whole != "0" {
+		exp = len(whole) - 1
+		sig = whole + frac
+	} else {
+		trimmed := strings.TrimLeft(frac, "0")
+		exp = len(trimmed) - len(frac) - 1
+		sig = trimmed
+	}
+	sig = strings.TrimRight(sig, "0")
+	if sig == "" {
+		return s // a zero.
+	}
+
+	hint := "e" + strconv.Itoa(exp)
+	places := maxLen - len(prefix) - len("0.") - len(hint)
+	if len(sig)-1 <= places {
+		if len(sig) == 1 {
+			return prefix + sig + hint
+		}
+		return prefix + sig[:1] + "." + sig[1:] + hint
+	}
+
+	places-- // room for the '…'.
+	if places <= 0 {
+		return prefix + sig[:1] + "…" + hint
+	}
+	return prefix + sig[:1] + "." + sig[1:1+places] + "…" + hint
+}
+
+// superscripts are the Unicode superscript forms of the digits 0 to 9.
+var superscripts = [...]rune{'⁰', '¹', '²', '³', '⁴', '⁵', '⁶', '⁷', '⁸', '⁹'}
+
+// StringScientificUnicode returns n in scientific notation for display, with
+// the mantissa rounded half up to places decimal places and the exponent in
+// Unicode superscript digits, e.g. 1230 with 2 places is "1.23×10³".
+// Zero is "0×10⁰" (padded to places). Overflow returns the String form, and
+// NaN or a negative places returns "NaN".
+func (n Numeric) StringScientificUnicode(places int) string {
+	if n.z.isNaN() || places < 0 {
+		return "NaN"
+	}
+	if n.z.isOverflow() {
+		return n.String()
+	}
+
+	var m f24
+	exp, ok := arith.log10Floor(&n.z)
+	if ok {
+		// m = n / 10^exp has a single whole digit.
+		var p, w f24
+		arith.pow10(&p, exp)
+		arith.div(&w, &n.z, &p)
+		arith.round(&m, &w, places, RoundHalfUp)
+		if m[1].val() >= 10 {
+			// rounded up to 10, e.g. 9.996 to 2 places.
+			exp++
+			w, m = m, f24{}
+			arith.div(&m, &w, &f24{0, 10})
+		}
+	}
+
+	var b strings.Builder
+	s := Numeric{z: m}.String()
+	b.WriteString(s)
+	if places > 0 {
+		decimals := 0
+		if dp := strings.IndexByte(s, '.'); dp >= 0 {
+			decimals = len(s) - dp - 1
+		} else {
+			b.WriteByte('.')
+		}
+		for range places - decimals {
+			b.WriteByte('0')
+		}
+	}
+
+	b.WriteString("×10")
+	for _, c := range strconv.Itoa(exp) {
+		if c == '-' {
+			b.WriteRune('⁻')
+			continue
+		}
+		b.WriteRune(superscripts[c-'0'])
+	}
+	return b.String()
+}
+
+// Put writes the String representation of n into dst without allocating and
+// returns the number of bytes written. If dst is too small nothing is written
+// and ErrBufferTooSmall is returned. A destination of 64 bytes always suffices.
+func (n Numeric) Put(dst []byte) (int, error) {
+	var buf [maxStringLen]byte
+	d := n.z.Digits()
+	b := d.appendString(buf[:0])
+	if len(b) > len(dst) {
+		return 0, ErrBufferTooSmall
+	}
+	return copy(dst, b), nil
+}
+
+// AppendText implements encoding.TextAppender, appending the String
+// representation of n to b. At most one allocation is made to grow b.
+func (n Numeric) AppendText(b []byte) ([]byte, error) {
+	var buf [maxStringLen]byte
+	d := n.z.Digits()
+	return append(b, d.appendString(buf[:0])...), nil
+}
+
+// appendString appends the String representation of the digits to b.
+func (d *digits) appendString(b []byte) []byte {
+	return d.appendGrouped(b, GroupWestern, 0, '.')
+}
+
+// appendGrouped appends the grouped representation of the digits to b.
+// A zero sep disables grouping.
+func (d *digits) appendGrouped(b []byte, style GroupStyle, sep, decSep rune) []byte {
+	if d.isNaN {
+		return append(b, "NaN"...)
+	}
+	if d.isUnderflow {
+		b = append(b, '~')
+	}
+	if d.signed() {
+		b = append(b, '-')
+	}
+	if d.isOverflow {
+		b = append(b, '<')
+	}
+	if d.count == 0 {
+		return append(b, '0')
+	}
+	if d.pointIdx == 0 {
+		b = append(b, '0')
+	} else {
+		for i, v := range d.v[:d.pointIdx] {
+			if sep != 0 && i > 0 && style.separatorBefore(d.pointIdx-i) {
+				b = utf8.AppendRune(b, sep)
+			}
+			b = append(b, '0'+v)
+		}
+	}
+
+	if d.count-d.pointIdx > 0 {
+		var dotted bool
+		var dp int
+		var zeros int
+		for _, v := range d.v[d.pointIdx:d.count] {
+			if dp == maxDecimalPlaces {
+				break
+			}
+			dp++
+			if v == 0 {
+				zeros++
+				continue
+			}
+			if !dotted {
+				b = utf8.AppendRune(b, decSep)
+				dotted = true
+			}
+			for range zeros {
+				b = append(b, '0')
+			}
+			zeros = 0
+			b = append(b, '0'+v)
+		}
+	}
+	return b
+}
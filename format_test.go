@@ -0,0 +1,271 @@
+package numeric
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGroupStyleString(t *testing.T) {
+	tests := []struct {
+		style GroupStyle
+		want  string
+	}{
+		{GroupWestern, "western"},
+		{GroupIndian, "indian"},
+		{GroupStyle(99), ""},
+	}
+
+	for _, tc := range tests {
+		if got := tc.style.String(); got != tc.want {
+			t.Errorf("GroupStyle(%d).String() = %q, want %q", tc.style, got, tc.want)
+		}
+	}
+}
+
+func TestNumericStringGroupedStyle(t *testing.T) {
+	type testCase struct {
+		input    string
+		style    GroupStyle
+		sep      rune
+		decSep   rune
+		expected string
+	}
+
+	tests := []testCase{
+		{"0", GroupWestern, ',', '.', "0"},
+		{"12", GroupWestern, ',', '.', "12"},
+		{"123", GroupWestern, ',', '.', "123"},
+		{"1234", GroupWestern, ',', '.', "1,234"},
+		{"1234567.89", GroupWestern, ',', '.', "1,234,567.89"},
+		{"-1234567.89", GroupWestern, ',', '.', "-1,234,567.89"},
+		{"0.125", GroupWestern, ',', '.', "0.125"},
+		{"1234567.89", GroupWestern, '.', ',', "1.234.567,89"},
+		{"1234567.89", GroupWestern, ' ', ',', "1 234 567,89"},
+		{"1234567.89", GroupWestern, ' ', ',', "1 234 567,89"},
+
+		{"123", GroupIndian, ',', '.', "123"},
+		{"1234", GroupIndian, ',', '.', "1,234"},
+		{"12345", GroupIndian, ',', '.', "12,345"},
+		{"1234567.89", GroupIndian, ',', '.', "12,34,567.89"},
+		{"-123456789", GroupIndian, ',', '.', "-12,34,56,789"},
+		{"123456789012345678", GroupIndian, ',', '.', "1,23,45,67,89,01,23,45,678"},
+
+		{"~1234.5", GroupWestern, ',', '.', "~1,234.5"},
+		{"-<1", GroupWestern, ',', '.', "-<999,999,999,999,999,999.999999999999999999999999999999999999"},
+		{"NaN", GroupIndian, ',', '.', "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.style.String(), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			got := n.StringGroupedStyle(tc.style, tc.sep, tc.decSep)
+			if got != tc.expected {
+				t.Errorf("StringGroupedStyle(%q, %v) = %q, want %q", tc.input, tc.style, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericStringGrouped(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"9876543.21", "9,876,543.21"},
+		{"9876543", "9,876,543"},
+		{"-9876543.21", "-9,876,543.21"},
+		{"-987", "-987"},
+		{"~-1000.5", "~-1,000.5"},
+		{"<1", "<999,999,999,999,999,999.999999999999999999999999999999999999"},
+		{"NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		n, err := FromString(tc.input)
+		if err != nil {
+			t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+		}
+		if got := n.StringGrouped(',', '.'); got != tc.expected {
+			t.Errorf("StringGrouped(%q) = %q, want %q", tc.input, got, tc.expected)
+		}
+	}
+}
+
+func TestDigitsRenderersAgree(t *testing.T) {
+	// digits holding more than the 36 decimal places, as left by a shift,
+	// render only the places a Numeric can hold.
+	var d digits
+	d.v[0] = 1
+	for i := 1; i <= 40; i++ {
+		d.v[i] = uint8(i % 10)
+	}
+	d.pointIdx, d.count = 1, 41
+	want := "1.123456789012345678901234567890123456"
+
+	if got := d.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := string(d.output(nil)); got != want {
+		t.Errorf("output() = %q, want %q", got, want)
+	}
+	if got := string(d.appendGrouped(nil, GroupWestern, ',', '.')); got != want {
+		t.Errorf("appendGrouped() = %q, want %q", got, want)
+	}
+}
+
+func TestNumericPut(t *testing.T) {
+	inputs := []string{
+		"0", "1", "-1", "123.456", "~0", "~-0.5", "NaN",
+		"0.000000000000000000000000000000000001",
+		"-<999999999999999999.999999999999999999999999999999999999",
+		"~-<1",
+	}
+
+	for _, in := range inputs {
+		t.Run(in, func(t *testing.T) {
+			n, err := FromString(in)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", in, err)
+			}
+			var buf [64]byte
+			l, err := n.Put(buf[:])
+			if err != nil {
+				t.Fatalf("Put(%q) failed: %v", in, err)
+			}
+			if got, want := string(buf[:l]), n.String(); got != want {
+				t.Errorf("Put(%q) = %q, want %q", in, got, want)
+			}
+		})
+	}
+}
+
+func TestNumericAppendText(t *testing.T) {
+	for _, in := range []string{"0", "-123.456", "~0.5", "NaN", "-<1"} {
+		n, _ := FromString(in)
+		b, err := n.AppendText([]byte("x="))
+		if err != nil {
+			t.Fatalf("AppendText(%q) failed: %v", in, err)
+		}
+		if got, want := string(b), "x="+n.String(); got != want {
+			t.Errorf("AppendText(%q) = %q, want %q", in, got, want)
+		}
+
+		var buf [64]byte
+		if allocs := testing.AllocsPerRun(100, func() { _, _ = n.AppendText(buf[:0]) }); allocs != 0 {
+			t.Errorf("AppendText(%q) into a large enough buffer allocated %v times", in, allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { _, _ = n.AppendText(nil) }); allocs != 1 {
+			t.Errorf("AppendText(%q, nil) allocated %v times, want 1", in, allocs)
+		}
+	}
+
+	b, _ := EmptyNaN{NaN()}.AppendText([]byte("x="))
+	if string(b) != "x=" {
+		t.Errorf("EmptyNaN AppendText(NaN) = %q, want %q", b, "x=")
+	}
+}
+
+func TestNumericPutBufferTooSmall(t *testing.T) {
+	n, _ := FromString("123.456")
+	var buf [6]byte
+	l, err := n.Put(buf[:])
+	if !errors.Is(err, ErrBufferTooSmall) {
+		t.Fatalf("Put() error = %v, want %v", err, ErrBufferTooSmall)
+	}
+	if l != 0 {
+		t.Errorf("Put() = %d, want 0", l)
+	}
+}
+
+func TestNumericPutNoAllocs(t *testing.T) {
+	n, _ := FromString("-123456789.123456789")
+	var buf [64]byte
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = n.Put(buf[:])
+	})
+	if allocs != 0 {
+		t.Errorf("Put() allocs = %v, want 0", allocs)
+	}
+}
+
+func TestNumericStringScientificUnicode(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		expected string
+	}
+
+	tests := []testCase{
+		{"1230", 2, "1.23×10³"},
+		{"1230", 4, "1.2300×10³"},
+		{"1230", 0, "1×10³"},
+		{"-1230", 2, "-1.23×10³"},
+		{"1.5", 1, "1.5×10⁰"},
+		{"0.00012345", 3, "1.235×10⁻⁴"},
+		{"-0.00012345", 1, "-1.2×10⁻⁴"},
+		{"9.996", 2, "1.00×10¹"},
+		{"999999999999999999", 3, "1.000×10¹⁸"},
+		{"123456789012345678", 5, "1.23457×10¹⁷"},
+		{"0.000000000000000000000000000000000001", 1, "1.0×10⁻³⁶"},
+		{"~0.333", 2, "3.33×10⁻¹"},
+		{"0", 2, "0.00×10⁰"},
+		{"<1", 2, "<999999999999999999.999999999999999999999999999999999999"},
+		{"NaN", 2, "NaN"},
+		{"1", -1, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.StringScientificUnicode(tc.places); got != tc.expected {
+				t.Errorf("StringScientificUnicode(%q, %d) = %q, want %q", tc.input, tc.places, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericStringTruncated(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxLen   int
+		expected string
+	}{
+		{"123.45", 10, "123.45"},
+		{"123.45", 6, "123.45"},
+		{"123456789.123456789", 9, "1.2345…e8"},
+		{"123456789.123456789", 12, "1.2345678…e8"},
+		{"-123456789.123456789", 9, "-1.234…e8"},
+		{"~123456789.123456789", 9, "~1.234…e8"},
+		{"0.000000000000000000000000000000123456", 12, "1.23456e-31"},
+		{"0.000000000000000000000000000000123456", 10, "1.234…e-31"},
+		{"-0.000000000000000000000000000000123456", 1, "-1…e-31"},
+		{"999999999999999999.999999999999999999999999999999999999", 20, "9.99999999999999…e17"},
+		{"100000000000", 5, "1e11"},
+		{"120000000000", 5, "1…e11"},
+		{"120000000000", 7, "1.2e11"},
+		{"0", 0, "0"},
+		{"~0", 1, "~0"},
+		{"<1", 12, "<9.99999…e17"},
+		{"-<1", 12, "-<9.9999…e17"},
+		{"<1", 1, "<9…e17"},
+		{"NaN", 1, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.StringTruncated(tc.maxLen); got != tc.expected {
+				t.Errorf("StringTruncated(%q, %d) = %q, want %q", tc.input, tc.maxLen, got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package numeric
+
+// niceSteps are the mantissas NiceStep snaps to, each with the (exclusive)
+// upper limit of the mantissa range that maps to it.
+var niceSteps = [...]struct {
+	limit f24
+	step  f24
+}{
+	{f24{0, 1, 500_000_000, 0, 0, 0}, f24{0, 1, 0, 0, 0, 0}},           // < 1.5  → 1
+	{f24{0, 2, 250_000_000, 0, 0, 0}, f24{0, 2, 0, 0, 0, 0}},           // < 2.25 → 2
+	{f24{0, 3, 750_000_000, 0, 0, 0}, f24{0, 2, 500_000_000, 0, 0, 0}}, // < 3.75 → 2.5
+	{f24{0, 7, 500_000_000, 0, 0, 0}, f24{0, 5, 0, 0, 0, 0}},           // < 7.5  → 5
+}
+
+// niceStepMax is the nice mantissa used when no other step applies.
+var niceStepMax = f24{0, 10, 0, 0, 0, 0}
+
+// NiceStep returns the "nice" chart axis step (1, 2, 2.5 or 5 times a power of ten)
+// nearest to roughStep. It is calculated exactly, without float conversion.
+// Zero, negative, NaN and overflowing steps return NaN.
+func NiceStep(roughStep Numeric) Numeric {
+	if roughStep.IsNaN() || roughStep.HasOverflow() || roughStep.Sign() < 0 {
+		return NaN()
+	}
+	exp, ok := arith.log10Floor(&roughStep.z)
+	if !ok {
+		return NaN()
+	}
+
+	var scale, mantissa f24
+	arith.pow10(&scale, exp)
+	arith.div(&mantissa, &roughStep.z, &scale)
+
+	step := &niceStepMax
+	for i := range niceSteps {
+		if arith.compare(&mantissa, &niceSteps[i].limit) < 0 {
+			step = &niceSteps[i].step
+			break
+		}
+	}
+
+	var z f24
+	arith.mul(&z, step, &scale)
+	return Numeric{z: z}
+}
@@ -0,0 +1,67 @@
+package numeric
+
+import (
+	"testing"
+)
+
+func TestNiceStep(t *testing.T) {
+	type testCase struct {
+		rough    string
+		expected string
+	}
+
+	tests := []testCase{
+		{"1", "1"},
+		{"1.4", "1"},
+		{"1.5", "2"},
+		{"2.2", "2"},
+		{"2.3", "2.5"},
+		{"3.7", "2.5"},
+		{"3.75", "5"},
+		{"7.4", "5"},
+		{"7.5", "10"},
+		{"9.99", "10"},
+		{"13", "10"},
+		{"18", "20"},
+		{"27", "25"},
+		{"420", "500"},
+		{"0.0031", "0.0025"},
+		{"0.07", "0.05"},
+		{"123456789012", "100000000000"},
+		{"0.000000000000000000000000000000000003", "~0.000000000000000000000000000000000002"},
+		{"800000000000000000", "<999999999999999999.999999999999999999999999999999999999"},
+		{"~0.3", "0.25"},
+		{"0", "NaN"},
+		{"-5", "NaN"},
+		{"NaN", "NaN"},
+		{"<1", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rough, func(t *testing.T) {
+			n, err := FromString(tc.rough)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.rough, err)
+			}
+			if got := NiceStep(n).String(); got != tc.expected {
+				t.Errorf("NiceStep(%q) = %q, want %q", tc.rough, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestF24Log10FloorAndPow10(t *testing.T) {
+	for exp := -maxDecimalPlaces; exp < maxWholeDigits; exp++ {
+		var z f24
+		arith.pow10(&z, exp)
+		got, ok := arith.log10Floor(&z)
+		if !ok || got != exp {
+			t.Errorf("log10Floor(pow10(%d)) = %d, %v", exp, got, ok)
+		}
+	}
+
+	var zero f24
+	if _, ok := arith.log10Floor(&zero); ok {
+		t.Errorf("log10Floor(0) reported ok")
+	}
+}
@@ -18,8 +18,11 @@ package numeric
 
 import (
 	"fmt"
+	"iter"
+	"math"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"unsafe"
 )
 
@@ -30,11 +33,30 @@ const (
 	// RoundAway rounds away from zero.
 	RoundAway
 
-	// RoundHalfDown rounds to nearest, but halves are rounded down.
+	// RoundHalfDown rounds to nearest, but halves are rounded down in magnitude (toward zero).
 	RoundHalfDown
 
-	// RoundHalfUp rounds to nearest, but halves are rounded up.
+	// RoundHalfUp rounds to nearest, but halves are rounded up in magnitude (away from zero).
 	RoundHalfUp
+
+	// RoundHalfEven rounds to nearest, but halves are rounded to the even digit (banker's rounding).
+	RoundHalfEven
+
+	// RoundCeil rounds toward positive infinity.
+	RoundCeil
+
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+const (
+	// RoundHalfAway rounds to nearest, with halves rounded away from zero (-2.5 → -3, 2.5 → 3).
+	// It is the sign-symmetric name for RoundHalfUp.
+	RoundHalfAway = RoundHalfUp
+
+	// RoundHalfToward rounds to nearest, with halves rounded toward zero (-2.5 → -2, 2.5 → 2).
+	// It is the sign-symmetric name for RoundHalfDown.
+	RoundHalfToward = RoundHalfDown
 )
 
 // RoundMode represents rounding behavior for Numeric.Round.
@@ -46,10 +68,45 @@ var roundModeString = map[RoundMode]string{
 	RoundAway:     "away",
 	RoundHalfDown: "1/2 down",
 	RoundHalfUp:   "1/2 up",
+	RoundHalfEven: "1/2 even",
+	RoundCeil:     "ceil",
+	RoundFloor:    "floor",
 }
 
 var Zero = Numeric{} // Zero represents the numeric zero value.
 
+// Common constants, built once so hot loops need not parse them. Numeric is a
+// value type, so using them never modifies the shared value.
+var (
+	Ten     = Numeric{z: f24Int(10)} // Ten represents 10.
+	Hundred = Numeric{z: hundred}    // Hundred represents 100.
+	Half    = Numeric{z: half}       // Half represents 0.5.
+)
+
+// constants maps the names accepted by Constant to their values.
+var constants = map[string]Numeric{
+	"zero":    Zero,
+	"one":     {z: f24Int(1)},
+	"ten":     Ten,
+	"hundred": Hundred,
+	"half":    Half,
+}
+
+// Constant returns the named constant: "zero", "one", "ten", "hundred" or
+// "half". The second result is false for an unknown name.
+func Constant(name string) (Numeric, bool) {
+	n, ok := constants[name]
+	return n, ok
+}
+
+const (
+	// MaxWholeDigits is the number of digits a Numeric holds before the decimal point.
+	MaxWholeDigits = maxWholeDigits
+
+	// MaxDecimalPlaces is the number of digits a Numeric holds after the decimal point.
+	MaxDecimalPlaces = maxDecimalPlaces
+)
+
 // String returns the string name for the RoundMode.
 func (rm RoundMode) String() string {
 	v, ok := roundModeString[rm]
@@ -64,6 +121,31 @@ type Numeric struct {
 	z f24
 }
 
+// Arith is the method set shared by Numeric and the types that embed it
+// (such as the nsql wrappers), allowing generic code to operate over any of them.
+//
+// The arithmetic methods take and return a plain Numeric, so generic code
+// accumulates into a Numeric:
+//
+//	func Sum[T numeric.Arith](xs []T) numeric.Numeric {
+//		var total numeric.Numeric
+//		for _, x := range xs {
+//			total = x.Add(total)
+//		}
+//		return total
+//	}
+type Arith interface {
+	Add(n2 Numeric) Numeric
+	Sub(n2 Numeric) Numeric
+	Mul(n2 Numeric) Numeric
+	Div(n2 Numeric) Numeric
+	Neg() Numeric
+	Abs() Numeric
+	Cmp(n2 Numeric) int
+	IsNaN() bool
+	String() string
+}
+
 // FromFloat64 creates a Numeric from a float64.
 // NOTE!!: Precision may be lost depending on internal representation.
 func FromFloat64(f float64) Numeric {
@@ -71,11 +153,78 @@ func FromFloat64(f float64) Numeric {
 }
 
 // FromInt creates a Numeric from an int.
+// Values beyond 999999999999999999 in magnitude return an overflow value.
 func FromInt(i int64) Numeric {
 	return Numeric{z: f24Int(i)}
 }
 
-// ValidateIntRange checks if an int is within the valid range for Numeric.
+// FromInt64Checked creates a Numeric from an int64, returning
+// ErrIntegerOutOfRange rather than an overflow value when the magnitude of v
+// exceeds 999999999999999999.
+func FromInt64Checked(v int64) (Numeric, error) {
+	if err := ValidateIntRange(v); err != nil {
+		return Numeric{}, err
+	}
+	return Numeric{z: f24Int(v)}, nil
+}
+
+// FromUint64Checked creates a Numeric from a uint64, returning
+// ErrIntegerOutOfRange when v exceeds 999999999999999999.
+func FromUint64Checked(v uint64) (Numeric, error) {
+	if v > maxValue {
+		return Numeric{}, fmt.Errorf("%w: %d", ErrIntegerOutOfRange, v)
+	}
+	var z f24
+	z[0].setVal(uint32(v / radix))
+	z[1].setVal(uint32(v % radix))
+	return Numeric{z: z}, nil
+}
+
+// FromScaledInt creates the Numeric mantissa * 10^-scale exactly, such as an
+// integer count of cents with scale 2, so FromScaledInt(12345, 2) is 123.45.
+// A negative scale multiplies up and may overflow, and a scale beyond the 36
+// decimal places may underflow.
+func FromScaledInt(mantissa int64, scale int) Numeric {
+	var d digits
+	u := uint64(mantissa)
+	if mantissa < 0 {
+		d.isNeg = true
+		u = -u
+	}
+	var buf [20]byte
+	for _, c := range strconv.AppendUint(buf[:0], u, 10) {
+		d.v[d.count] = c - '0'
+		d.count++
+	}
+	d.pointIdx = d.count
+	d.shift(-max(scale, -math.MaxInt)) // -math.MinInt would wrap.
+	return Numeric{z: d.F24()}
+}
+
+// FromWords creates a Numeric from the six radix 1e9 words returned by Words
+// and its flags, without parsing. Words 0 and 1 hold the 18 whole digits and
+// words 2 to 5 the 36 decimal places, most significant first, so the decimal
+// point sits between words 1 and 2: 12.5 is {0, 12, 500000000, 0, 0, 0}.
+// A word of 1e9 or more returns NaN, as does setting nan.
+func FromWords(words [6]uint32, neg, nan, overflow, underflow bool) Numeric {
+	if nan {
+		return NaN()
+	}
+	var z f24
+	for i, w := range words {
+		if uint64(w) >= radix {
+			return NaN()
+		}
+		z[i].setVal(w)
+	}
+	z.setNeg(neg)
+	z.setOverflow(overflow)
+	z.setUnderflow(underflow)
+	return Numeric{z: z}
+}
+
+// ValidateIntRange checks if an int is within the valid range for Numeric,
+// returning an error wrapping ErrIntegerOutOfRange if not.
 func ValidateIntRange(i int64) error {
 	if i > maxValueI || i < -maxValueI {
 		return fmt.Errorf("%w: %d", ErrIntegerOutOfRange, i)
@@ -83,7 +232,8 @@ func ValidateIntRange(i int64) error {
 	return nil
 }
 
-// ValidateFloatRange checks if an int is within the valid range for Numeric.
+// ValidateFloatRange checks if a float is within the valid range for Numeric,
+// returning an error wrapping ErrFloatOutOfRange if not.
 func ValidateFloatRange(i float64) error {
 	if i > maxValueF64 || i < -maxValueF64 {
 		return fmt.Errorf("%w: %f", ErrFloatOutOfRange, i)
@@ -91,6 +241,41 @@ func ValidateFloatRange(i float64) error {
 	return nil
 }
 
+// CanRepresentFloat64 reports whether f survives a round trip through
+// Numeric unchanged, i.e. FromFloat64(f).Float64() == f. NaN, infinities,
+// magnitudes beyond the whole digit range and subnormals return false, as do
+// values needing more than the first 18 decimal places, which Float64 drops.
+func CanRepresentFloat64(f float64) bool {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return false
+	}
+	z := f24Float64(f)
+	d := z.Digits()
+	return !d.isOverflow && !d.isUnderflow && d.Float64() == f
+}
+
+// ValidateAll checks each value in nums is finite, returning a slice the same
+// length as nums holding nil for a valid value, or an error matching
+// ErrNotANumber, ErrOverflow or ErrUnderflow for a NaN, overflowing or
+// underflowing value, so problems can be reported per field.
+func ValidateAll(nums []Numeric) []error {
+	errs := make([]error, len(nums))
+	for i, n := range nums {
+		if !n.IsUnderOverNaN() {
+			continue
+		}
+		switch {
+		case n.IsNaN():
+			errs[i] = fmt.Errorf("%w: index %d", ErrNotANumber, i)
+		case n.HasOverflow():
+			errs[i] = fmt.Errorf("%w: index %d: %s", ErrOverflow, i, n.String())
+		default:
+			errs[i] = fmt.Errorf("%w: index %d: %s", ErrUnderflow, i, n.String())
+		}
+	}
+	return errs
+}
+
 // One returns a positive or negative 1
 func One(isNeg bool) Numeric {
 	var f f24
@@ -99,6 +284,19 @@ func One(isNeg bool) Numeric {
 	return Numeric{z: f}
 }
 
+// MaxValue returns the largest finite Numeric,
+// 999999999999999999.999999999999999999999999999999999999.
+func MaxValue() Numeric {
+	return Numeric{z: maxF24}
+}
+
+// MinValue returns the most negative finite Numeric, the negation of MaxValue.
+func MinValue() Numeric {
+	f := maxF24
+	f.setNeg(true)
+	return Numeric{z: f}
+}
+
 // NaN returns a Numeric representing Not-a-Number (NaN).
 func NaN() Numeric {
 	var f f24
@@ -126,15 +324,186 @@ func Sum(vals ...Numeric) Numeric {
 	return Numeric{z: sum}
 }
 
+// SumSeq returns the sum of the Numerics produced by seq, without
+// materializing them in a slice.
+func SumSeq(seq iter.Seq[Numeric]) Numeric {
+	var sum f24
+	for n := range seq {
+		var z f24
+		arith.add(&z, &sum, &n.z)
+		sum = z
+	}
+	return Numeric{z: sum}
+}
+
+// Accumulator sums a stream of Numerics in place. It keeps a running total
+// plus a compensation term: Numeric addition is exact within range, so the
+// compensation holds any addend that would have overflowed the total, letting
+// a sum pass transiently beyond the representable range and come back, where
+// a fold with Add stays overflowed. The zero value is an empty Accumulator.
+// An Accumulator is not safe for concurrent use.
+type Accumulator struct {
+	sum   f24
+	comp  f24
+	isNaN bool
+}
+
+// Add adds n to the accumulated total. A NaN n makes the Accumulator NaN
+// until Reset.
+func (a *Accumulator) Add(n Numeric) {
+	if a.isNaN || n.z.isNaN() {
+		a.isNaN = true
+		return
+	}
+	var z f24
+	arith.add(&z, &a.sum, &n.z)
+	if z.isOverflow() && !a.sum.isOverflow() && !n.z.isOverflow() {
+		// hold the addend back in the compensation until the total comes back in range.
+		var c f24
+		arith.add(&c, &a.comp, &n.z)
+		if !c.isOverflow() {
+			a.comp = c
+			return
+		}
+	}
+	a.sum = z
+}
+
+// Result returns the accumulated total, NaN if a NaN was added since the last Reset.
+func (a *Accumulator) Result() Numeric {
+	if a.isNaN {
+		return NaN()
+	}
+	var z f24
+	arith.add(&z, &a.sum, &a.comp)
+	return Numeric{z: z}
+}
+
+// Reset clears the accumulated total.
+func (a *Accumulator) Reset() {
+	*a = Accumulator{}
+}
+
+// Avg returns the arithmetic mean of vals, skipping NaN values.
+// NaN is returned when vals is empty or only contains NaN.
+// The mean is calculated incrementally (mean += (x - mean) / count) so
+// intermediate values stay within the magnitude of the inputs, where
+// Sum(vals...).Div(count) would overflow.
+func Avg(vals ...Numeric) Numeric {
+	var mean f24
+	var count int64
+	for _, n := range vals {
+		if n.z.isNaN() {
+			continue
+		}
+		count++
+		c := f24Int(count)
+
+		var delta, step, z f24
+		arith.sub(&delta, &n.z, &mean)
+		if delta.isOverflow() && !n.z.isOverflow() && !mean.isOverflow() {
+			// opposite signs near the limit, step = x/count - mean/count.
+			var xs, ms f24
+			arith.div(&xs, &n.z, &c)
+			arith.div(&ms, &mean, &c)
+			arith.sub(&step, &xs, &ms)
+		} else {
+			arith.div(&step, &delta, &c)
+		}
+		arith.add(&z, &mean, &step)
+		mean = z
+	}
+	if count == 0 {
+		return NaN()
+	}
+	return Numeric{z: mean}
+}
+
+// Min returns the smallest of vals. NaN values are skipped, and NaN is
+// returned when vals is empty or only contains NaN.
+// A negative overflow is smaller than every other value.
+func Min(vals ...Numeric) Numeric {
+	return extreme(vals, -1)
+}
+
+// Max returns the largest of vals. NaN values are skipped, and NaN is
+// returned when vals is empty or only contains NaN.
+// A positive overflow is larger than every other value.
+func Max(vals ...Numeric) Numeric {
+	return extreme(vals, 1)
+}
+
+// Clamp returns lo if n is below lo, hi if n is above hi, otherwise n.
+// Overflows order beyond every finite bound, so a positive overflow clamps to hi.
+// NaN is returned if n, lo or hi is NaN, or if lo is greater than hi.
+func (n Numeric) Clamp(lo, hi Numeric) Numeric {
+	c, _ := n.ClampChecked(lo, hi)
+	return c
+}
+
+// ClampChecked returns the result of Clamp and true if n was outside the
+// range lo to hi, and so was clamped. A NaN result returns (NaN, true).
+func (n Numeric) ClampChecked(lo, hi Numeric) (Numeric, bool) {
+	if n.z.isNaN() || lo.z.isNaN() || hi.z.isNaN() || arith.order(&lo.z, &hi.z) > 0 {
+		return NaN(), true
+	}
+	switch {
+	case arith.order(&n.z, &lo.z) < 0:
+		return lo, true
+	case arith.order(&n.z, &hi.z) > 0:
+		return hi, true
+	}
+	return n, false
+}
+
+// extreme returns the value of vals that orders furthest in the direction of dir.
+func extreme(vals []Numeric, dir int) Numeric {
+	res := NaN()
+	for _, n := range vals {
+		if n.z.isNaN() {
+			continue
+		}
+		if res.z.isNaN() || arith.order(&n.z, &res.z) == dir {
+			res = n
+		}
+	}
+	return res
+}
+
 // Round returns a new Numeric rounded to the specified number of decimal places.
 // 'places' is digits after the decimal point. 0 means integer rounding.
 // Underflow is removed.
+//
+// Rounding only considers the 36 stored decimal places. Digits lost below
+// the last place are not consulted, even when the underflow flag records
+// that some were, so a stored tie at the 36th place is always treated as an
+// exact half, and places of 36 or more return the stored value unchanged
+// for every mode.
 func (n Numeric) Round(places int, mode RoundMode) Numeric {
 	var z f24
 	arith.round(&z, &n.z, places, mode)
 	return Numeric{z: z}
 }
 
+// ShiftLeft returns n multiplied by 10^places by moving the decimal point,
+// so 0.5 shifted left by 3 is exactly 500. Shifting beyond the whole digits
+// overflows, and a negative places shifts right. NaN and overflow are unchanged.
+func (n Numeric) ShiftLeft(places int) Numeric {
+	d := n.z.Digits()
+	d.shift(places)
+	return Numeric{z: d.F24()}
+}
+
+// ShiftRight returns n divided by 10^places by moving the decimal point,
+// so 500 shifted right by 3 is exactly 0.5. Digits shifted past the 36th
+// decimal place are dropped and mark underflow, and a negative places
+// shifts left. NaN and overflow are unchanged.
+func (n Numeric) ShiftRight(places int) Numeric {
+	d := n.z.Digits()
+	d.shift(-max(places, -math.MaxInt)) // -math.MinInt would wrap.
+	return Numeric{z: d.F24()}
+}
+
 // Float64 converts the Numeric to a float64.
 // NOTE!!: Precision loss possible; not safe for financial calculations.
 func (n Numeric) Float64() float64 {
@@ -142,6 +511,14 @@ func (n Numeric) Float64() float64 {
 	return d.Float64()
 }
 
+// Float32 converts the Numeric to the nearest float32, rounding the decimal
+// value directly rather than via float64. NaN returns NaN and overflows ±Inf.
+// NOTE!!: Precision loss possible; not safe for financial calculations.
+func (n Numeric) Float32() float32 {
+	d := n.z.Digits()
+	return d.Float32()
+}
+
 // Int converts the Numeric to an int, discarding any fractional part.
 // NOTE!!: Overflows are masked to int range; no error is returned.
 func (n Numeric) Int() int64 {
@@ -156,6 +533,160 @@ func (n Numeric) Int() int64 {
 	return i
 }
 
+// Int64 converts the Numeric to an int64, truncating any fractional part
+// toward zero. ErrNotANumber is returned for NaN and ErrIntegerOutOfRange if
+// n has overflowed or its whole part does not fit in an int64.
+func (n Numeric) Int64() (int64, error) {
+	switch {
+	case n.z.isNaN():
+		return 0, ErrNotANumber
+	case n.z.isOverflow():
+		return 0, fmt.Errorf("%w: %s", ErrIntegerOutOfRange, n.String())
+	}
+	v := (uint64(n.z[0].val()) * radix) + uint64(n.z[1].val())
+	if v > math.MaxInt64 {
+		return 0, fmt.Errorf("%w: %s", ErrIntegerOutOfRange, n.String())
+	}
+	if n.z.isNeg() {
+		return -int64(v), nil
+	}
+	return int64(v), nil
+}
+
+// Digits calls fn for each significant decimal digit of n, most significant
+// first, with its place relative to the decimal point: whole digits count up
+// from 1 for the units and decimal places down from -1, so 12.34 gives 1 at 2,
+// 2 at 1, 3 at -1 and 4 at -2. Zeros between significant digits are included
+// and zero itself has no digits. The sign and underflow marker are not
+// reported. Iteration stops early if fn returns false. NaN and overflow call
+// fn zero times and return true, otherwise Digits returns false.
+func (n Numeric) Digits(fn func(digit uint8, placeFromPoint int) bool) bool {
+	if n.z.isNaN() || n.z.isOverflow() {
+		return true
+	}
+	d := n.z.Digits()
+	lead := true
+	for i, v := range d.v[:d.count] {
+		if lead && v == 0 {
+			continue
+		}
+		lead = false
+		place := d.pointIdx - i
+		if place <= 0 {
+			place--
+		}
+		if !fn(v, place) {
+			break
+		}
+	}
+	return false
+}
+
+// ScaledInt returns n * 10^scale as an int64, rounding the discarded digits
+// using mode, such as a price in integer cents with scale 2: 123.456 with
+// RoundHalfUp gives 12346. The inverse of FromScaledInt. A negative scale
+// divides down. ErrNotANumber is returned for NaN and ErrIntegerOutOfRange
+// if n has overflowed or the scaled integer does not fit in an int64.
+func (n Numeric) ScaledInt(scale int, mode RoundMode) (int64, error) {
+	switch {
+	case n.z.isNaN():
+		return 0, ErrNotANumber
+	case n.z.isOverflow():
+		return 0, fmt.Errorf("%w: %s", ErrIntegerOutOfRange, n.String())
+	}
+	if scale < 0 {
+		n = n.ShiftRight(-scale)
+		scale = 0
+	}
+	r := n.Round(scale, mode)
+
+	limit := uint64(math.MaxInt64)
+	if r.z.isNeg() {
+		limit++
+	}
+	var v uint64
+	ok := true
+	mul10 := func(d uint8) {
+		if v > (limit-uint64(d))/10 {
+			ok = false
+			return
+		}
+		v = v*10 + uint64(d)
+	}
+
+	// accumulate the digits, then scale up by the exponent of the last one.
+	var exp int
+	r.Digits(func(d uint8, place int) bool {
+		mul10(d)
+		exp = place + scale
+		if place > 0 {
+			exp--
+		}
+		return ok
+	})
+	for ; exp > 0 && ok; exp-- {
+		mul10(0)
+	}
+	if !ok {
+		return 0, fmt.Errorf("%w: %s scaled by %d", ErrIntegerOutOfRange, n.String(), scale)
+	}
+	if r.z.isNeg() {
+		return int64(-v), nil
+	}
+	return int64(v), nil
+}
+
+// Words returns the six radix 1e9 words holding the magnitude of n, without
+// the sign, NaN, overflow and underflow flags. Each word is below 1e9; words
+// 0 and 1 hold the whole digits and words 2 to 5 the decimal places, with the
+// decimal point between words 1 and 2. See FromWords.
+func (n Numeric) Words() [6]uint32 {
+	var words [6]uint32
+	for i := range n.z {
+		words[i] = n.z[i].val()
+	}
+	return words
+}
+
+// Scale returns the number of decimal places needed to represent n, ignoring
+// trailing zeros, e.g. 123.4500 has a scale of 2 and 123 a scale of 0.
+// Underflowed values return the scale of their representable digits,
+// NaN and overflows return 0.
+func (n Numeric) Scale() int {
+	if n.z.isNaN() || n.z.isOverflow() {
+		return 0
+	}
+	d := n.z.Digits()
+	return d.count - d.pointIdx
+}
+
+// SignificantDigits returns the number of significant figures in n, counted
+// from the first to the last non zero digit, e.g. 0.0042 has 2 and 1200 has 2.
+// Trailing whole zeros are significant when n has a fractional part, so
+// 1200.5 has 5. Trailing decimal zeros are not stored, so 0.00420 has 2.
+// Zero, NaN and overflows return 0.
+func (n Numeric) SignificantDigits() int {
+	if n.z.isNaN() || n.z.isOverflow() {
+		return 0
+	}
+	d := n.z.Digits()
+	first, last := -1, d.count
+	for i, v := range d.v[:d.count] {
+		if v != 0 {
+			if first < 0 {
+				first = i
+			}
+			if d.count == d.pointIdx {
+				last = i + 1
+			}
+		}
+	}
+	if first < 0 {
+		return 0
+	}
+	return last - first
+}
+
 // String returns the decimal string representation of the number.
 // This function allocates to the heap the return string.
 func (n Numeric) String() string {
@@ -163,6 +694,37 @@ func (n Numeric) String() string {
 	return d.String()
 }
 
+// displayPrecision holds the display precision plus one, so the zero value
+// is full precision.
+var displayPrecision atomic.Int32
+
+// SetDisplayPrecision sets the decimal places used when a Numeric is printed
+// with the fmt verbs %v, %s and %q, which round half up to places as
+// StringWithPrecision does. A places of -1 restores full precision, the
+// default, and places above MaxDecimalPlaces are treated as MaxDecimalPlaces.
+// String, and the text, JSON and other encodings, always use full precision.
+// It is safe for concurrent use.
+func SetDisplayPrecision(places int) {
+	displayPrecision.Store(int32(min(max(places, -1), maxDecimalPlaces) + 1))
+}
+
+// DisplayPrecision returns the decimal places set by SetDisplayPrecision,
+// -1 for full precision.
+func DisplayPrecision() int {
+	return int(displayPrecision.Load()) - 1
+}
+
+// StringWithPrecision returns n rounded half up to places decimal places and
+// formatted like String, without changing n. The ~ underflow marker is
+// dropped by the rounding, so 1/3 with 4 places is "0.3333". A negative places
+// returns the full precision String form.
+func (n Numeric) StringWithPrecision(places int) string {
+	if places < 0 {
+		return n.String()
+	}
+	return n.Round(places, RoundHalfUp).String()
+}
+
 // Add returns the sum of n and n2.
 func (n Numeric) Add(n2 Numeric) Numeric {
 	var z f24
@@ -184,6 +746,37 @@ func (n Numeric) Mul(n2 Numeric) Numeric {
 	return Numeric{z: z}
 }
 
+// AddSat returns n + n2, saturating to MaxValue or MinValue rather than
+// overflowing, so the result is always finite. NaN propagates.
+// NOTE!!: A saturated result is not the true sum.
+func (n Numeric) AddSat(n2 Numeric) Numeric {
+	return saturate(n.Add(n2))
+}
+
+// SubSat returns n - n2, saturating to MaxValue or MinValue rather than
+// overflowing, so the result is always finite. NaN propagates.
+// NOTE!!: A saturated result is not the true difference.
+func (n Numeric) SubSat(n2 Numeric) Numeric {
+	return saturate(n.Sub(n2))
+}
+
+// MulSat returns n * n2, saturating to MaxValue or MinValue rather than
+// overflowing, so the result is always finite. NaN propagates.
+// NOTE!!: A saturated result is not the true product.
+func (n Numeric) MulSat(n2 Numeric) Numeric {
+	return saturate(n.Mul(n2))
+}
+
+// saturate replaces an overflow with the largest finite value of the same sign.
+func saturate(n Numeric) Numeric {
+	if n.z.isNaN() || !n.z.isOverflow() {
+		return n
+	}
+	z := maxF24
+	z.setNeg(n.z.isNeg())
+	return Numeric{z: z}
+}
+
 // Div returns the quotient of n divided by n2.
 func (n Numeric) Div(n2 Numeric) Numeric {
 	var z f24
@@ -191,13 +784,148 @@ func (n Numeric) Div(n2 Numeric) Numeric {
 	return Numeric{z: z}
 }
 
-// TruncateTo returns n rounded down to the nearest integer.
-func (n Numeric) Truncate(n2 Numeric) Numeric {
+// PercentChange returns the relative change from old to n, (n - old) / old,
+// as a fraction, so a change from 80 to 100 is 0.25; use Percent to display it
+// as 25%. It is computed as n / old - 1, so n - old cannot overflow. An inexact
+// result carries the underflow marker. A zero old or NaN input returns NaN.
+func (n Numeric) PercentChange(old Numeric) Numeric {
+	var q, z f24
+	one := f24{0, 1}
+	arith.div(&q, &n.z, &old.z)
+	arith.sub(&z, &q, &one)
+	return Numeric{z: z}
+}
+
+// RatioTo returns the ratio n / base, carrying the underflow marker if
+// inexact. A zero base or NaN input returns NaN.
+func (n Numeric) RatioTo(base Numeric) Numeric {
+	var z f24
+	arith.div(&z, &n.z, &base.z)
+	return Numeric{z: z}
+}
+
+// DivRound returns n / n2 rounded to places decimal places using mode, so
+// 1 / 3 to 4 places with RoundHalfUp is 0.3333 without the underflow marker.
+// Places of 36 or more return the full precision quotient, keeping the marker
+// if inexact. A zero divisor, NaN input or negative places returns NaN.
+func (n Numeric) DivRound(n2 Numeric, places int, mode RoundMode) Numeric {
+	var q f24
+	arith.div(&q, &n.z, &n2.z)
+	if places >= maxDecimalPlaces && !q.isNaN() {
+		return Numeric{z: q}
+	}
+	var z f24
+	arith.round(&z, &q, places, mode)
+	return Numeric{z: z}
+}
+
+// DivChecked returns the quotient of n divided by n2 like Div, but returns
+// ErrNotANumber if either operand is NaN and ErrDivideByZero if n2 is zero
+// (including ~0) instead of a silent NaN.
+func (n Numeric) DivChecked(n2 Numeric) (Numeric, error) {
+	switch {
+	case n.z.isNaN() || n2.z.isNaN():
+		return NaN(), ErrNotANumber
+	case n2.z.isZero():
+		return NaN(), ErrDivideByZero
+	}
+	return n.Div(n2), nil
+}
+
+// MulAdd returns n + a * b, adding the exact product so the result is
+// rounded (truncated) once rather than twice. A product too small to be
+// represented on its own is still absorbed into the sum. NaN in any operand gives NaN.
+func (n Numeric) MulAdd(a, b Numeric) Numeric {
+	var z f24
+	arith.muladd(&z, &n.z, &a.z, &b.z)
+	return Numeric{z: z}
+}
+
+// MulDiv returns a * b / c without overflowing on the a * b intermediate,
+// e.g. amount * rate / 100. Only a final result beyond the Numeric range
+// overflows. A zero c returns NaN.
+func MulDiv(a, b, c Numeric) Numeric {
+	var z f24
+	arith.muldiv(&z, &a.z, &b.z, &c.z)
+	return Numeric{z: z}
+}
+
+// DivTerminates returns the quotient of n divided by n2 and whether it is exact,
+// i.e. the decimal expansion terminates within 36 decimal places (1/8) rather
+// than being truncated (1/3). Quotients that are NaN, overflow or underflow,
+// including division by zero, are not exact.
+func (n Numeric) DivTerminates(n2 Numeric) (Numeric, bool) {
+	q := n.Div(n2)
+	return q, !q.IsUnderOverNaN()
+}
+
+// Pow returns n raised to the integer power exp.
+// Negative exponents return the reciprocal of n^-exp, and n^0 is 1 for every
+// value other than NaN, including 0^0.
+// Overflowing results are marked as overflow, and inexact reciprocals as underflow.
+func (n Numeric) Pow(exp int) Numeric {
+	var z f24
+	arith.pow(&z, &n.z, exp)
+	return Numeric{z: z}
+}
+
+// Sqrt returns the square root of n, truncated to 36 decimal places.
+// Inexact (irrational) roots are marked as underflow, negative values return NaN.
+func (n Numeric) Sqrt() Numeric {
+	var z f24
+	arith.sqrt(&z, &n.z)
+	return Numeric{z: z}
+}
+
+// Truncate returns n truncated toward zero to the nearest integer.
+// Use TruncateTo to truncate at a decimal place.
+func (n Numeric) Truncate() Numeric {
 	var z f24
 	arith.round(&z, &n.z, 0, RoundTowards)
 	return Numeric{z: z}
 }
 
+// Frac returns the fractional part of n, n minus its truncation, keeping the
+// sign of n so -1.25 gives -0.25. The whole digits are cleared directly, so
+// the decimal places are returned exactly. NaN returns NaN and an overflowing
+// value returns zero.
+func (n Numeric) Frac() Numeric {
+	switch {
+	case n.z.isNaN():
+		return NaN()
+	case n.z.isOverflow():
+		return Zero
+	}
+	z := n.z
+	z[0].setVal(0)
+	z[1].setVal(0)
+	z.setNeg(shouldBeNeg(&z, n.z.isNeg()))
+	return Numeric{z: z}
+}
+
+// TruncateTo returns n truncated toward zero to places decimal places,
+// so 1.239 truncated to 2 places is 1.23. Underflow is removed.
+// A negative places returns NaN, as for Round.
+func (n Numeric) TruncateTo(places int) Numeric {
+	var z f24
+	arith.round(&z, &n.z, places, RoundTowards)
+	return Numeric{z: z}
+}
+
+// Ceil returns the smallest integer value greater than or equal to n.
+func (n Numeric) Ceil() Numeric {
+	var z f24
+	arith.round(&z, &n.z, 0, RoundCeil)
+	return Numeric{z: z}
+}
+
+// Floor returns the largest integer value less than or equal to n.
+func (n Numeric) Floor() Numeric {
+	var z f24
+	arith.round(&z, &n.z, 0, RoundFloor)
+	return Numeric{z: z}
+}
+
 // DivRem returns the integer quotient and remainder of n / n2.
 func (n Numeric) DivRem(n2 Numeric) (Numeric, Numeric) {
 	var r, q f24
@@ -205,6 +933,77 @@ func (n Numeric) DivRem(n2 Numeric) (Numeric, Numeric) {
 	return Numeric{z: q}, Numeric{z: r}
 }
 
+// IntDiv returns the floor of n / n2, the largest integer not greater than
+// the quotient, so -7 / 2 is -4. This differs from the truncated DivRem
+// quotient for negative, inexact quotients. A zero divisor, NaN input or an
+// overflowing quotient returns NaN.
+func (n Numeric) IntDiv(n2 Numeric) Numeric {
+	var q, r f24
+	arith.divRem(&q, &r, &n.z, &n2.z)
+	if !r.isNaN() && !r.isZero() && n.z.isNeg() != n2.z.isNeg() {
+		var z f24
+		one := f24{0, 1}
+		arith.sub(&z, &q, &one)
+		return Numeric{z: z}
+	}
+	return Numeric{z: q}
+}
+
+// Mod returns the remainder of n / n2, with the sign of n, matching math.Mod.
+// A zero modulus, NaN input or an overflowing quotient returns NaN.
+func (n Numeric) Mod(n2 Numeric) Numeric {
+	var q, r f24
+	arith.divRem(&q, &r, &n.z, &n2.z)
+	return Numeric{z: r}
+}
+
+// AddMod returns (n + n2) mod modulus, at least 0 and below |modulus|,
+// wrapping rather than overflowing. Both operands are reduced by the modulus before
+// adding, so the result is exact even when n + n2 itself would overflow.
+// A zero, non integer or NaN modulus, or a NaN or overflowing operand,
+// returns NaN.
+func (n Numeric) AddMod(n2, modulus Numeric) Numeric {
+	if !arith.isExactInt(&modulus.z) || modulus.z.isZero() {
+		return NaN()
+	}
+	var m, a, b f24
+	arith.abs(&m, &modulus.z)
+	modEuclid(&a, &n.z, &m)
+	modEuclid(&b, &n2.z, &m)
+	if a.isNaN() || b.isNaN() {
+		return NaN()
+	}
+
+	// a + b is below 2m, so subtract the gap to m when the sum would reach it.
+	var gap, z f24
+	arith.sub(&gap, &m, &b)
+	if arith.order(&a, &gap) >= 0 {
+		arith.sub(&z, &a, &gap)
+	} else {
+		arith.add(&z, &a, &b)
+	}
+	return Numeric{z: z}
+}
+
+// modEuclid sets z to x mod m, at least 0 and below m, for a positive m.
+func modEuclid(z, x, m *f24) {
+	var q, r f24
+	arith.divRem(&q, &r, x, m)
+	if !r.isNaN() && r.isNeg() && !r.isZero() {
+		arith.add(z, &r, m)
+		return
+	}
+	*z = r
+}
+
+// DivisibleBy returns true if n is an exact integer multiple of n2, i.e.
+// n mod n2 is exactly zero. Fractional values are supported (0.3 is divisible
+// by 0.1). A zero divisor, NaN, overflow or underflow returns false.
+func (n Numeric) DivisibleBy(n2 Numeric) bool {
+	r := n.Mod(n2)
+	return !r.IsUnderOverNaN() && r.IsZero()
+}
+
 // Neg returns the negated value of n.
 func (n Numeric) Neg() Numeric {
 	var z f24
@@ -212,6 +1011,19 @@ func (n Numeric) Neg() Numeric {
 	return Numeric{z: z}
 }
 
+// NegSigned returns n with its sign flipped, like Neg, except a zero becomes
+// a negative zero rather than staying positive, for IEEE interop: Float64 of
+// a negative zero returns -0. String still shows a negative zero as 0 unless
+// underflow is set. NaN is returned unchanged.
+func (n Numeric) NegSigned() Numeric {
+	if n.z.isNaN() {
+		return n
+	}
+	z := n.z
+	z.setNeg(!z.isNeg())
+	return Numeric{z: z}
+}
+
 // Abs returns the absolute value of n.
 func (n Numeric) Abs() Numeric {
 	var z f24
@@ -224,7 +1036,8 @@ func (n Numeric) IsNaN() bool {
 	return n.z.isNaN()
 }
 
-// Sign returns -1 if negative, 1 if zero or positive, 0 for NaN
+// Sign returns -1 if negative, 1 if zero or positive, 0 for NaN.
+// Unlike math/big, zero returns 1; use Sign3 for the three-valued sign.
 func (n Numeric) Sign() int {
 	switch {
 	case n.z.isNaN():
@@ -236,6 +1049,41 @@ func (n Numeric) Sign() int {
 	}
 }
 
+// Sign3 returns -1 if negative, 0 if zero and 1 if positive, matching the
+// three-valued sign of math/big. Unlike Sign, an exact zero, including a
+// negative zero, returns 0, while an underflowed zero returns the sign of
+// the tiny value it stands for. NaN returns 0.
+func (n Numeric) Sign3() int {
+	switch {
+	case n.z.isNaN():
+		return 0
+	case n.z.isZero() && !n.z.isUnderflow():
+		return 0
+	case n.z.isNeg():
+		return -1
+	default:
+		return 1
+	}
+}
+
+// IsPositive returns true if n is strictly greater than zero, including a positive overflow.
+// It returns false for zero and NaN.
+func (n Numeric) IsPositive() bool {
+	return !n.z.isNaN() && !n.z.isNeg() && !n.z.isZero()
+}
+
+// IsNegative returns true if n is strictly less than zero, including a negative overflow.
+// It returns false for zero (even the negative underflow ~-0) and NaN.
+func (n Numeric) IsNegative() bool {
+	return !n.z.isNaN() && n.z.isNeg() && !n.z.isZero()
+}
+
+// IsInteger returns true if n has no decimal places.
+// It returns false for NaN, overflow and underflow, whose exact values are unknown.
+func (n Numeric) IsInteger() bool {
+	return !arith.hasExceptionalState(&n.z) && arith.zeroFrom(&n.z, decIndex)
+}
+
 // HasOverflow returns true if the number has overflowed.
 func (n Numeric) HasOverflow() bool {
 	if n.z.isNaN() {
@@ -252,6 +1100,16 @@ func (n Numeric) HasUnderflow() bool {
 	return n.z.isUnderflow()
 }
 
+// IsRepeating returns true if n is an inexact quotient, marked ~, whose
+// decimal expansion does not terminate within 36 places. It keys off the
+// underflow flag, so every repeating decimal such as 1/3 is reported, but so
+// is a terminating expansion longer than 36 places, such as 1/2^40, or any
+// other underflowed value; a quotient that terminates within 36 places, such
+// as 1/8, is not. NaN and overflow return false.
+func (n Numeric) IsRepeating() bool {
+	return !n.z.isNaN() && !n.z.isOverflow() && n.z.isUnderflow()
+}
+
 // IsZero returns true if the number is exactly zero.
 func (n Numeric) IsZero() bool {
 	if n.z.isNaN() {
@@ -261,10 +1119,33 @@ func (n Numeric) IsZero() bool {
 }
 
 // IsEqual returns true if n == n2, considering special flags.
+// Exact zeros are equal whatever their sign.
 func (n Numeric) IsEqual(n2 Numeric) bool {
 	return arith.equal(&n.z, &n2.z)
 }
 
+// Normalize returns n in canonical form, so numerically equal values are
+// identical and can key an equality cache: 3.1400 normalizes to 3.14. Values
+// are stored in fixed width, so trailing fractional zeros never reach the
+// digits and the only change needed is clearing the sign of an exact negative
+// zero. The result is IsEqual to n. NaN and overflow are returned unchanged.
+func (n Numeric) Normalize() Numeric {
+	if n.z.isNaN() || n.z.isOverflow() {
+		return n
+	}
+	z := n.z
+	z.setNeg(shouldBeNeg(&z, z.isNeg()))
+	return Numeric{z: z}
+}
+
+// IsIdentical returns true if n and n2 have the same internal state, value
+// units and all flags, bit for bit. This is structural identity rather than
+// numeric equality: NaN is identical to NaN and an overflow to an overflow of
+// the same sign, while 1 and ~1 are not identical.
+func (n Numeric) IsIdentical(n2 Numeric) bool {
+	return n.z == n2.z
+}
+
 // IsLessThan returns true if n < n2.
 func (n Numeric) IsLessThan(n2 Numeric) bool {
 	return arith.compare(&n.z, &n2.z) < 0
@@ -292,21 +1173,156 @@ func (n Numeric) IsGreaterEqual(n2 Numeric) bool {
 //
 //	0 if n == n2,
 //	1 if n > n2.
+//
+// Exact zeros compare equal whatever their sign.
 func (n Numeric) Cmp(n2 Numeric) int {
 	return arith.compare(&n.z, &n2.z)
 }
 
-// IsUnderOverNaN returns true if the number is NaN, has overflow, or underflow.
+// CmpMagnitude compares n to n2 like Cmp, but by their stored values alone,
+// disregarding the overflow and underflow flags. Cmp orders ~0.5 just above
+// 0.5, while CmpMagnitude treats them as equal, and an overflow compares as
+// MaxValue or MinValue rather than beyond them. Zero equals zero whatever its
+// sign. NaN sorts first, and two NaNs compare equal.
+func (n Numeric) CmpMagnitude(n2 Numeric) int {
+	switch {
+	case n.z.isNaN() && n2.z.isNaN():
+		return 0
+	case n.z.isNaN():
+		return -1
+	case n2.z.isNaN():
+		return 1
+	}
+	xs := n.z.isNeg() && !n.z.isZero()
+	ys := n2.z.isNeg() && !n2.z.isZero()
+	switch {
+	case xs && !ys:
+		return -1
+	case ys && !xs:
+		return 1
+	}
+	cmp := arith.unsignedCompare(&n.z, &n2.z)
+	if xs {
+		return -cmp
+	}
+	return cmp
+}
+
+// nanHash is the Hash of every NaN.
+const nanHash = uint64(1<<64 - 1)
+
+// Hash returns a 64-bit FNV-1a hash of n for use in hash based lookups.
+// Hash equality follows IsEqual, not IsIdentical: values that are numerically
+// equal hash the same however they were written, e.g. 3.00 and 3, and zero
+// hashes the same regardless of sign. The underflow and overflow markers are
+// included in the hash, and every NaN hashes to the same fixed value.
+func (n Numeric) Hash() uint64 {
+	if n.z.isNaN() {
+		return nanHash
+	}
+
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+	h := uint64(offset)
+	for i := range n.z {
+		v := n.z[i].val()
+		for range 4 {
+			h ^= uint64(v & 0xff)
+			h *= prime
+			v >>= 8
+		}
+	}
+
+	var flags uint64
+	if n.z.isNeg() && !n.z.isZero() {
+		flags |= 1
+	}
+	if n.z.isOverflow() {
+		flags |= 2
+	}
+	if n.z.isUnderflow() {
+		flags |= 4
+	}
+	h ^= flags
+	h *= prime
+	return h
+}
+
+// CmpOrdered compares n to n2 like Cmp, but the second result is false when
+// either operand is NaN, in which case the comparison is undefined and the
+// first result is meaningless.
+func (n Numeric) CmpOrdered(n2 Numeric) (int, bool) {
+	if n.z.isNaN() || n2.z.isNaN() {
+		return 0, false
+	}
+	return arith.compare(&n.z, &n2.z), true
+}
+
+// IsUnderOverNaN returns true if the number is NaN, has overflow, or underflow,
+// i.e. its State is not StateNormal.
 func (n *Numeric) IsUnderOverNaN() bool {
 	return arith.hasExceptionalState(&n.z)
 }
 
+const (
+	// StateNormal is a finite value stored exactly.
+	StateNormal State = iota
+
+	// StateNaN is not a number.
+	StateNaN
+
+	// StateOverflow is a value too large to represent.
+	StateOverflow
+
+	// StateUnderflow is a value with digits lost below the last decimal place.
+	StateUnderflow
+)
+
+// State identifies whether a Numeric is normal or the exceptional condition it is in.
+type State int
+
+// stateString maps State values to human-readable strings.
+var stateString = map[State]string{
+	StateNormal:    "normal",
+	StateNaN:       "NaN",
+	StateOverflow:  "overflow",
+	StateUnderflow: "underflow",
+}
+
+// String returns the string name for the State.
+func (st State) String() string {
+	v, ok := stateString[st]
+	if ok {
+		return v
+	}
+	return ""
+}
+
+// State returns the condition of n. A value may carry more than one flag,
+// e.g. "~-<1" is both underflow and overflow, so the most severe is returned:
+// StateNaN, then StateOverflow, then StateUnderflow.
+func (n Numeric) State() State {
+	switch {
+	case n.z.isNaN():
+		return StateNaN
+	case n.z.isOverflow():
+		return StateOverflow
+	case n.z.isUnderflow():
+		return StateUnderflow
+	}
+	return StateNormal
+}
+
 // MarshalText implements encoding.TextMarshaler for text formats.
 func (n Numeric) MarshalText() ([]byte, error) {
-	return []byte(n.String()), nil
+	return n.AppendText(nil)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler for text formats.
+// Leading and trailing Unicode whitespace is ignored, but whitespace within
+// the number, such as "1 23", is an error.
 func (n *Numeric) UnmarshalText(text []byte) error {
 	var s string
 	if l := len(text); l != 0 {
@@ -321,22 +1337,153 @@ func (n *Numeric) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// EmptyNaN wraps a Numeric for formats, such as legacy CSV, that represent
+// a missing value with an empty field rather than the literal "NaN". Text,
+// JSON and XML all write NaN as empty, the JSON string "" or an empty element,
+// and decode empty as NaN, so the convention round-trips.
+type EmptyNaN struct {
+	Numeric
+}
+
+// MarshalText implements encoding.TextMarshaler, returning empty text for NaN.
+func (e EmptyNaN) MarshalText() ([]byte, error) {
+	if e.IsNaN() {
+		return []byte{}, nil
+	}
+	return e.Numeric.MarshalText()
+}
+
+// AppendText implements encoding.TextAppender, appending nothing for NaN.
+func (e EmptyNaN) AppendText(b []byte) ([]byte, error) {
+	if e.IsNaN() {
+		return b, nil
+	}
+	return e.Numeric.AppendText(b)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding empty text as NaN.
+func (e *EmptyNaN) UnmarshalText(text []byte) error {
+	return e.Numeric.UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler, returning the empty string "" for NaN
+// whatever the SetJSONNaNMode setting.
+func (e EmptyNaN) MarshalJSON() ([]byte, error) {
+	if e.IsNaN() {
+		return []byte(`""`), nil
+	}
+	return e.Numeric.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding "" and null as NaN.
+func (e *EmptyNaN) UnmarshalJSON(data []byte) error {
+	if string(data) == `""` {
+		e.Numeric = NaN()
+		return nil
+	}
+	return e.Numeric.UnmarshalJSON(data)
+}
+
+const (
+	// NaNAsString marshals NaN to JSON as the string "NaN", the default.
+	NaNAsString NaNMode = iota
+
+	// NaNAsNull marshals NaN to JSON as null.
+	NaNAsNull
+
+	// NaNAsError makes marshalling NaN to JSON fail with ErrNotANumber.
+	NaNAsError
+)
+
+// NaNMode selects how MarshalJSON renders NaN.
+type NaNMode int
+
+// nanModeString maps NaNMode values to human-readable strings.
+var nanModeString = map[NaNMode]string{
+	NaNAsString: "string",
+	NaNAsNull:   "null",
+	NaNAsError:  "error",
+}
+
+// String returns the string name for the NaNMode.
+func (m NaNMode) String() string {
+	return nanModeString[m]
+}
+
+// jsonNaNMode holds the NaNMode used by MarshalJSON.
+var jsonNaNMode atomic.Int32
+
+// SetJSONNaNMode sets how MarshalJSON renders NaN. UnmarshalJSON accepts
+// both null and "NaN" whatever the mode. It is safe for concurrent use.
+func SetJSONNaNMode(mode NaNMode) {
+	jsonNaNMode.Store(int32(mode))
+}
+
+// JSONNaNMode returns the NaNMode set by SetJSONNaNMode.
+func JSONNaNMode() NaNMode {
+	return NaNMode(jsonNaNMode.Load())
+}
+
 // MarshalJSON implements json.Marshaler.
-// NaN is serialized as the string "NaN".
+// NaN is serialized as the string "NaN", or as set by SetJSONNaNMode.
 func (n Numeric) MarshalJSON() ([]byte, error) {
 	if n.IsNaN() {
+		switch JSONNaNMode() {
+		case NaNAsNull:
+			return []byte("null"), nil
+		case NaNAsError:
+			return nil, ErrNotANumber
+		}
 		return []byte(`"NaN"`), nil
 	}
 	return []byte(`"` + n.String() + `"`), nil
 }
 
+// jsonStrict is set when UnmarshalJSON rejects overflow and underflow.
+var jsonStrict atomic.Bool
+
+// SetJSONStrict sets whether UnmarshalJSON returns an error matching
+// ErrOverflow or ErrUnderflow, as FromStringValidated does, when a quoted or
+// unquoted value overflows or underflows, rather than decoding the flagged
+// value. The default is lenient. It is safe for concurrent use.
+func SetJSONStrict(strict bool) {
+	jsonStrict.Store(strict)
+}
+
+// JSONStrict returns the mode set by SetJSONStrict.
+func JSONStrict() bool {
+	return jsonStrict.Load()
+}
+
 // UnmarshalJSON implements json.Unmarshaler for Numeric.
-// Parses quoted decimal strings. Returns error on invalid input.
+// Parses quoted decimal strings, and null as NaN. Returns error on invalid
+// input. Successful parses do not allocate.
 func (n *Numeric) UnmarshalJSON(data []byte) error {
-	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
-		return n.UnmarshalText(data)
+	if string(data) == "null" {
+		*n = NaN()
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
 	}
-	return n.UnmarshalText(data[1 : len(data)-1])
+	var s string
+	if l := len(data); l != 0 {
+		s = unsafe.String(&data[0], l)
+	}
+	if JSONStrict() {
+		nn, err := FromStringValidated(s)
+		if err != nil {
+			return err
+		}
+		*n = nn
+		return nil
+	}
+	z, err := f24String(s)
+	if err != nil {
+		return err
+	}
+	n.z = z
+	return nil
 }
 
 // Format implements the fmt.Formatter interface for the Numeric type.
@@ -356,6 +1503,7 @@ func (n *Numeric) UnmarshalJSON(data []byte) error {
 //	  q  | Quoted string format using String() (e.g., "123.45")
 //	other| Unsupported verb; output will be: %!<verb>(Numeric=<value>)
 //
+// The v, s and q verbs round to the places set by SetDisplayPrecision, if any.
 // The method respects width and precision flags defined by fmt.State.
 // For example, %.2f will format to 2 decimal places.
 //
@@ -372,7 +1520,7 @@ func (n Numeric) Format(f fmt.State, verb rune) {
 	fmtS := buildFormatString(f, verb)
 	switch verb {
 	case 'v':
-		s := n.String()
+		s := n.StringWithPrecision(DisplayPrecision())
 		if f.Flag('#') {
 			fmt.Fprintf(f, "Numeric(%s)", s)
 		} else {
@@ -385,7 +1533,7 @@ func (n Numeric) Format(f fmt.State, verb rune) {
 		v := n.Int()
 		fmt.Fprintf(f, fmtS, v)
 	case 's', 'q':
-		s := n.String()
+		s := n.StringWithPrecision(DisplayPrecision())
 		fmt.Fprintf(f, fmtS, s)
 	default:
 		s := n.String()
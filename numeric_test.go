@@ -1,9 +1,13 @@
 package numeric
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -18,6 +22,9 @@ func TestRoundModeString(t *testing.T) {
 		{RoundAway, "away"},
 		{RoundHalfDown, "1/2 down"},
 		{RoundHalfUp, "1/2 up"},
+		{RoundHalfEven, "1/2 even"},
+		{RoundCeil, "ceil"},
+		{RoundFloor, "floor"},
 		{RoundMode(99), ""}, // unknown mode
 	}
 
@@ -116,6 +123,44 @@ func TestOversizedWholeToFloat64Conversion(t *testing.T) {
 	}
 }
 
+func TestNumericFloat32(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float32
+	}{
+		{"0", 0},
+		{"0.1", 0.1},
+		{"-2.5", -2.5},
+		{"3.14159265358979323846", 3.1415927},
+		{"123456.789", 123456.79},
+		{"0.000000000000000000000000000000000001", 1e-36},
+		// just above the tie between 1 and the next float32, via float64 this
+		// rounds to the tie and then to even, giving 1.
+		{"1.00000005960464477539062500001", 1.0000001},
+		{"999999999999999999", 1e18},
+	}
+
+	for _, tc := range tests {
+		n, err := FromString(tc.input)
+		if err != nil {
+			t.Fatalf("FromString(%q) error: %v", tc.input, err)
+		}
+		if got := n.Float32(); got != tc.expected {
+			t.Errorf("FromString(%q).Float32() = %g, want %g", tc.input, got, tc.expected)
+		}
+	}
+
+	if got := NaN().Float32(); !math.IsNaN(float64(got)) {
+		t.Errorf("NaN().Float32() = %g, want NaN", got)
+	}
+	if got := (Numeric{z: overflow(false)}).Float32(); !math.IsInf(float64(got), 1) {
+		t.Errorf("overflow Float32() = %g, want +Inf", got)
+	}
+	if got := (Numeric{z: overflow(true)}).Float32(); !math.IsInf(float64(got), -1) {
+		t.Errorf("negative overflow Float32() = %g, want -Inf", got)
+	}
+}
+
 func TestFromInt_Positive(t *testing.T) {
 	n := FromInt(42)
 	expected := f24Int(42)
@@ -213,6 +258,103 @@ func TestFromInt_Int_RoundTrip(t *testing.T) {
 	}
 }
 
+func TestNumericInt64(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		err      error
+	}{
+		{"NaN", 0, ErrNotANumber},
+		{"1e19", 0, ErrIntegerOutOfRange},
+		{"-1e19", 0, ErrIntegerOutOfRange},
+		{"0", 0, nil},
+		{"999999999999999999", maxValueI, nil},
+		{"-999999999999999999.999", -maxValueI, nil},
+		{"12.99", 12, nil},
+		{"-12.99", -12, nil},
+		{"0.5", 0, nil},
+	}
+
+	for _, tc := range tests {
+		n, _ := FromString(tc.input)
+		v, err := n.Int64()
+		if !errors.Is(err, tc.err) || v != tc.expected {
+			t.Errorf("FromString(%q).Int64() = %d, %v, want %d, %v", tc.input, v, err, tc.expected, tc.err)
+		}
+	}
+}
+
+func TestNumericScale(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"0", 0},
+		{"0.000", 0},
+		{"123", 0},
+		{"1200", 0},
+		{"123.4500", 2},
+		{"-123.45", 2},
+		{"0.1", 1},
+		{"0.000000001", 9},
+		{"0.0000000010", 9},
+		{"1.000000000100000000", 10},
+		{"0.000000000000000000000000000000000001", 36},
+		{"~1.25", 2},
+		{"1e-40", 0},
+		{"~0.333333333333333333333333333333333333", 36},
+		{"NaN", 0},
+		{"<1", 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.Scale(); got != tc.want {
+				t.Errorf("Scale(%q) = %d, want %d", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNumericSignificantDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"1", 1},
+		{"123.45", 5},
+		{"-123.45", 5},
+		{"0.00420", 2}, // trailing decimal zeros are not stored.
+		{"0.0042", 2},
+		{"0.000000000000000000000000000000000001", 1},
+		{"1200", 2},
+		{"1200.5", 5},
+		{"1000000000000000000e-1", 1},
+		{"100.001", 6},
+		{"999999999999999999.999999999999999999999999999999999999", 54},
+		{"~0.333", 3},
+		{"0", 0},
+		{"NaN", 0},
+		{"<1", 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q): %v", tc.input, err)
+			}
+			if got := n.SignificantDigits(); got != tc.expected {
+				t.Errorf("SignificantDigits(%q) = %d, want %d", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestFromStringAndString(t *testing.T) {
 	type testCase struct {
 		input     string
@@ -310,6 +452,254 @@ func TestNumericSum(t *testing.T) {
 	}
 }
 
+func TestNumericSumSeq(t *testing.T) {
+	tests := [][]string{
+		{},
+		{"1", "2", "3"},
+		{"100.25", "-0.25", "~0.5"},
+		{"999999999999999999", "1"},
+		{"1", "NaN"},
+	}
+
+	for _, inputs := range tests {
+		var vals []Numeric
+		for _, s := range inputs {
+			n, _ := FromString(s)
+			vals = append(vals, n)
+		}
+		want := Sum(vals...)
+		got := SumSeq(slices.Values(vals))
+		if got != want {
+			t.Errorf("SumSeq(%v) = %q, want %q", inputs, got.String(), want.String())
+		}
+	}
+
+	// values produced lazily by a generator.
+	seq := func(yield func(Numeric) bool) {
+		for i := int64(1); yield(FromInt(i)) && i < 100; i++ {
+		}
+	}
+	if got := SumSeq(seq).String(); got != "5050" {
+		t.Errorf("SumSeq(1..100) = %q, want 5050", got)
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	tests := []struct {
+		inputs   []string
+		expected string
+	}{
+		{[]string{}, "0"},
+		{[]string{"1", "2", "3"}, "6"},
+		{[]string{"100.25", "-0.25", "~0.5"}, "~100.5"},
+		{[]string{"900000000000000000", "900000000000000000", "-900000000000000000"}, "900000000000000000"},
+		{[]string{"-900000000000000000", "-900000000000000000", "1", "900000000000000000"}, "-899999999999999999"},
+		{[]string{"999999999999999999", "1"}, "<999999999999999999.999999999999999999999999999999999999"},
+		{[]string{"1", "NaN", "2"}, "NaN"},
+	}
+
+	for _, tc := range tests {
+		var acc Accumulator
+		for _, s := range tc.inputs {
+			n, _ := FromString(s)
+			acc.Add(n)
+		}
+		if got := acc.Result().String(); got != tc.expected {
+			t.Errorf("Accumulator(%v) = %q, want %q", tc.inputs, got, tc.expected)
+		}
+	}
+}
+
+func TestAccumulatorManySmall(t *testing.T) {
+	large, _ := FromString("123456789012345678.9")
+	small, _ := FromString("0.000000000000000000000000000000000001")
+
+	acc := Accumulator{}
+	acc.Add(large)
+	naive := large
+	const count = 1_000_000
+	for range count {
+		acc.Add(small)
+		naive = naive.Add(small)
+	}
+
+	want, _ := FromString("123456789012345678.900000000000000000000000000001")
+	if got := acc.Result(); !got.IsIdentical(want) {
+		t.Errorf("Accumulator = %q, want %q", got, want)
+	}
+	if !naive.IsIdentical(want) {
+		t.Errorf("naive fold = %q, want %q", naive, want)
+	}
+}
+
+func TestAccumulatorReset(t *testing.T) {
+	var acc Accumulator
+	acc.Add(FromInt(5))
+	acc.Add(NaN())
+	acc.Add(FromInt(1))
+	if !acc.Result().IsNaN() {
+		t.Fatalf("Accumulator after NaN = %q, want NaN", acc.Result())
+	}
+
+	acc.Reset()
+	acc.Add(FromInt(7))
+	if got := acc.Result().String(); got != "7" {
+		t.Errorf("Accumulator after Reset = %q, want 7", got)
+	}
+}
+
+func sumArith[T Arith](xs []T) Numeric {
+	var total Numeric
+	for _, x := range xs {
+		total = x.Add(total)
+	}
+	return total
+}
+
+func TestArithGenericSum(t *testing.T) {
+	a, _ := FromString("100.25")
+	b, _ := FromString("-0.75")
+	if got := sumArith([]Numeric{a, b, FromInt(1)}).String(); got != "100.5" {
+		t.Errorf("sumArith = %q, want %q", got, "100.5")
+	}
+	if got := sumArith([]Numeric{a, NaN()}); !got.IsNaN() {
+		t.Errorf("sumArith with NaN = %q, want NaN", got.String())
+	}
+}
+
+func TestNumericClamp(t *testing.T) {
+	type testCase struct {
+		input, lo, hi string
+		expected      string
+		clamped       bool
+	}
+
+	tests := []testCase{
+		{"5", "1", "10", "5", false},
+		{"1", "1", "10", "1", false},
+		{"10", "1", "10", "10", false},
+		{"0.5", "1", "10", "1", true},
+		{"-3", "-2.5", "2.5", "-2.5", true},
+		{"11", "1", "10", "10", true},
+		{"~10.000000000000000000000000000000000001", "1", "10", "10", true},
+		{"<1", "1", "10", "10", true},
+		{"-<1", "1", "10", "1", true},
+		{"5", "5", "5", "5", false},
+		{"5", "10", "1", "NaN", true},
+		{"NaN", "1", "10", "NaN", true},
+		{"5", "NaN", "10", "NaN", true},
+		{"5", "1", "NaN", "NaN", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.lo+"_"+tc.hi, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			lo, _ := FromString(tc.lo)
+			hi, _ := FromString(tc.hi)
+			if got := n.Clamp(lo, hi).String(); got != tc.expected {
+				t.Errorf("Clamp(%q, %q, %q) = %q, want %q", tc.input, tc.lo, tc.hi, got, tc.expected)
+			}
+			c, clamped := n.ClampChecked(lo, hi)
+			if c.String() != tc.expected || clamped != tc.clamped {
+				t.Errorf("ClampChecked(%q, %q, %q) = %q, %v, want %q, %v", tc.input, tc.lo, tc.hi, c, clamped, tc.expected, tc.clamped)
+			}
+		})
+	}
+}
+
+func TestNumericAvg(t *testing.T) {
+	type testCase struct {
+		name     string
+		inputs   []string
+		expected string
+	}
+
+	tests := []testCase{
+		{"empty", []string{}, "NaN"},
+		{"all NaN", []string{"NaN", "NaN"}, "NaN"},
+		{"single", []string{"1.5"}, "1.5"},
+		{"simple", []string{"1", "2", "3", "4"}, "2.5"},
+		{"skips NaN", []string{"NaN", "1", "NaN", "2"}, "1.5"},
+		{"negative", []string{"-1", "-2", "-6"}, "-3"},
+		{"inexact", []string{"1", "1", "2"}, "~1.333333333333333333333333333333333333"},
+		{"large", []string{"900000000000000000", "900000000000000000", "900000000000000000"}, "900000000000000000"},
+		{"large mixed", []string{"800000000000000000", "900000000000000000", "700000000000000000", "600000000000000000"}, "750000000000000000"},
+		{"opposite limits", []string{"-900000000000000000", "900000000000000000"}, "0"},
+		{"overflow", []string{"1", "<1"}, "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var vals []Numeric
+			for _, s := range tc.inputs {
+				n, err := FromString(s)
+				if err != nil {
+					t.Fatalf("FromString(%q) failed: %v", s, err)
+				}
+				vals = append(vals, n)
+			}
+
+			if got := Avg(vals...).String(); got != tc.expected {
+				t.Errorf("Avg(%v) = %q, want %q", tc.inputs, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericAvgNaiveOverflow(t *testing.T) {
+	n, _ := FromString("999999999999999999")
+	vals := []Numeric{n, n, n, n}
+	if naive := Sum(vals...).Div(FromInt(int64(len(vals)))); !naive.HasOverflow() {
+		t.Fatalf("expected naive average to overflow, got %q", naive.String())
+	}
+	if got := Avg(vals...); got.String() != n.String() {
+		t.Errorf("Avg() = %q, want %q", got.String(), n.String())
+	}
+}
+
+func TestNumericMinMax(t *testing.T) {
+	type testCase struct {
+		name    string
+		inputs  []string
+		wantMin string
+		wantMax string
+	}
+
+	const maxStr = "<999999999999999999.999999999999999999999999999999999999"
+	tests := []testCase{
+		{"empty", []string{}, "NaN", "NaN"},
+		{"single", []string{"1.5"}, "1.5", "1.5"},
+		{"all NaN", []string{"NaN", "NaN"}, "NaN", "NaN"},
+		{"simple", []string{"3", "-1", "2.5", "0"}, "-1", "3"},
+		{"skips NaN", []string{"NaN", "3", "NaN", "-2", "NaN"}, "-2", "3"},
+		{"fractions", []string{"0.000000000000000000000000000000000001", "0", "-0.000000000000000000000000000000000001"}, "-0.000000000000000000000000000000000001", "0.000000000000000000000000000000000001"},
+		{"positive overflow", []string{"1", maxStr, "999999999999999999"}, "1", maxStr},
+		{"negative overflow", []string{"1", "-" + maxStr, "-999999999999999999"}, "-" + maxStr, "1"},
+		{"both overflows", []string{"-" + maxStr, "0", maxStr}, "-" + maxStr, maxStr},
+		{"underflow", []string{"~1", "1", "0.5"}, "0.5", "~1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var vals []Numeric
+			for _, s := range tc.inputs {
+				n, err := FromString(s)
+				if err != nil {
+					t.Fatalf("FromString(%q) failed: %v", s, err)
+				}
+				vals = append(vals, n)
+			}
+
+			if got := Min(vals...).String(); got != tc.wantMin {
+				t.Errorf("Min(%v) = %q, want %q", tc.inputs, got, tc.wantMin)
+			}
+			if got := Max(vals...).String(); got != tc.wantMax {
+				t.Errorf("Max(%v) = %q, want %q", tc.inputs, got, tc.wantMax)
+			}
+		})
+	}
+}
+
 func TestNumericRound_Modes(t *testing.T) {
 	type testCase struct {
 		input    string
@@ -336,6 +726,8 @@ func TestNumericRound_Modes(t *testing.T) {
 		{"-2.5", 0, RoundHalfDown, "-2"},
 		{"2.6", 0, RoundHalfDown, "3"},
 		{"2.4", 0, RoundHalfDown, "2"},
+		{"0.5000000001", 0, RoundHalfDown, "1"},
+		{"-1.250000000000000000000000000000000001", 1, RoundHalfDown, "-1.3"},
 
 		// RoundHalfUp: ties go up
 		{"2.5", 0, RoundHalfUp, "3"},
@@ -343,6 +735,15 @@ func TestNumericRound_Modes(t *testing.T) {
 		{"2.4", 0, RoundHalfUp, "2"},
 		{"2.6", 0, RoundHalfUp, "3"},
 
+		// RoundHalfEven: ties go to the even digit
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"-2.5", 0, RoundHalfEven, "-2"},
+		{"-3.5", 0, RoundHalfEven, "-4"},
+		{"2.45", 1, RoundHalfEven, "2.4"},
+		{"2.55", 1, RoundHalfEven, "2.6"},
+		{"2.4500000000000000000000000000000001", 1, RoundHalfEven, "2.5"},
+
 		// Rounding to decimal places
 		{"1.23456789", 4, RoundTowards, "1.2345"},
 		{"1.23456789", 4, RoundAway, "1.2346"},
@@ -373,6 +774,152 @@ func TestNumericRound_Modes(t *testing.T) {
 	}
 }
 
+func TestNumericRound_WordBoundaries(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		mode     RoundMode
+		expected string
+	}
+
+	const digits = "0.123456789123456789123456789123456789"
+	const nines = "0.999999999999999999999999999999999999"
+
+	tests := []testCase{
+		{digits, 8, RoundHalfUp, "0.12345679"},
+		{digits, 9, RoundHalfUp, "0.123456789"},
+		{digits, 10, RoundHalfUp, "0.1234567891"},
+		{digits, 17, RoundHalfUp, "0.12345678912345679"},
+		{digits, 18, RoundHalfUp, "0.123456789123456789"},
+		{digits, 19, RoundHalfUp, "0.1234567891234567891"},
+		{digits, 27, RoundTowards, "0.123456789123456789123456789"},
+		{digits, 35, RoundHalfUp, "0.12345678912345678912345678912345679"},
+		{digits, 36, RoundHalfUp, digits},
+
+		{nines, 8, RoundHalfUp, "1"},
+		{nines, 9, RoundHalfUp, "1"},
+		{nines, 9, RoundTowards, "0.999999999"},
+		{nines, 10, RoundHalfUp, "1"},
+		{nines, 17, RoundHalfDown, "1"},
+		{nines, 18, RoundHalfEven, "1"},
+		{nines, 18, RoundFloor, "0.999999999999999999"},
+		{nines, 19, RoundCeil, "1"},
+		{nines, 27, RoundAway, "1"},
+		{nines, 35, RoundHalfUp, "1"},
+		{nines, 36, RoundHalfUp, nines},
+
+		// a tie exactly at the unit boundary.
+		{"0.0000000005", 9, RoundHalfUp, "0.000000001"},
+		{"0.0000000005", 9, RoundHalfDown, "0"},
+		{"0.0000000005", 9, RoundHalfEven, "0"},
+		{"0.0000000015", 9, RoundHalfEven, "0.000000002"},
+		{"-0.0000000005", 9, RoundHalfUp, "-0.000000001"},
+		{"0.0000000000000000005", 18, RoundHalfUp, "0.000000000000000001"},
+		{"0.0000000000000000015", 18, RoundHalfEven, "0.000000000000000002"},
+		{"0.0000000000000000000000000005", 27, RoundHalfUp, "0.000000000000000000000000001"},
+		{"0.0000000000000000000000000025", 27, RoundHalfEven, "0.000000000000000000000000002"},
+		{"0.000000000000000000000000000000000005", 35, RoundHalfUp, "0.00000000000000000000000000000000001"},
+
+		// carry from the first decimal unit into the whole units.
+		{"999999999.9999999995", 9, RoundHalfUp, "1000000000"},
+		{"-999999999.9999999995", 9, RoundHalfUp, "-1000000000"},
+		{"999999999999999999.9999999995", 9, RoundHalfUp, "<999999999999999999.999999999999999999999999999999999999"},
+		{"-999999999999999999.5", 0, RoundHalfUp, "-<999999999999999999.999999999999999999999999999999999999"},
+		{"999999999999999999.000000001", 0, RoundCeil, "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s_%d_%v", tc.input, tc.places, tc.mode), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.Round(tc.places, tc.mode).String(); got != tc.expected {
+				t.Errorf("Round(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.mode, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericRound_ModeMatrix(t *testing.T) {
+	inputs := []string{"-3.5", "-2.5", "-0.5", "0.5", "2.5", "3.5"}
+
+	tests := []struct {
+		mode     RoundMode
+		expected []string
+	}{
+		{RoundTowards, []string{"-3", "-2", "0", "0", "2", "3"}},
+		{RoundAway, []string{"-4", "-3", "-1", "1", "3", "4"}},
+		{RoundHalfToward, []string{"-3", "-2", "0", "0", "2", "3"}},
+		{RoundHalfAway, []string{"-4", "-3", "-1", "1", "3", "4"}},
+		{RoundHalfDown, []string{"-3", "-2", "0", "0", "2", "3"}},
+		{RoundHalfUp, []string{"-4", "-3", "-1", "1", "3", "4"}},
+		{RoundHalfEven, []string{"-4", "-2", "0", "0", "2", "4"}},
+		{RoundCeil, []string{"-3", "-2", "0", "1", "3", "4"}},
+		{RoundFloor, []string{"-4", "-3", "-1", "0", "2", "3"}},
+	}
+
+	for _, tc := range tests {
+		for i, in := range inputs {
+			t.Run(in+"_"+tc.mode.String(), func(t *testing.T) {
+				n, err := FromString(in)
+				if err != nil {
+					t.Fatalf("Invalid input %q: %v", in, err)
+				}
+				if got := n.Round(0, tc.mode).String(); got != tc.expected[i] {
+					t.Errorf("Round(%q, 0, %v) = %q, want %q", in, tc.mode, got, tc.expected[i])
+				}
+			})
+		}
+	}
+}
+
+func TestNumericCeilFloor(t *testing.T) {
+	type testCase struct {
+		input     string
+		wantCeil  string
+		wantFloor string
+	}
+
+	tests := []testCase{
+		{"0", "0", "0"},
+		{"1", "1", "1"},
+		{"-1", "-1", "-1"},
+		{"1.2", "2", "1"},
+		{"-1.2", "-1", "-2"},
+		{"1.5", "2", "1"},
+		{"-1.5", "-1", "-2"},
+		{"0.000000000000000000000000000000000001", "1", "0"},
+		{"-0.000000000000000000000000000000000001", "0", "-1"},
+		{"123456789.999999999", "123456790", "123456789"},
+		{"-123456789.999999999", "-123456789", "-123456790"},
+		{"~1.2", "2", "1"},
+		{"~-1.2", "-1", "-2"},
+		{"NaN", "NaN", "NaN"},
+		{"<1", "<999999999999999999.999999999999999999999999999999999999", "<999999999999999999.999999999999999999999999999999999999"},
+		{"-<1", "-<999999999999999999.999999999999999999999999999999999999", "-<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("Invalid input %q: %v", tc.input, err)
+			}
+
+			if got := n.Ceil().String(); got != tc.wantCeil {
+				t.Errorf("Ceil(%q) = %q, want %q", tc.input, got, tc.wantCeil)
+			}
+			if got := n.Floor().String(); got != tc.wantFloor {
+				t.Errorf("Floor(%q) = %q, want %q", tc.input, got, tc.wantFloor)
+			}
+			if got, want := n.Round(0, RoundCeil).String(), tc.wantCeil; got != want {
+				t.Errorf("Round(%q, 0, RoundCeil) = %q, want %q", tc.input, got, want)
+			}
+		})
+	}
+}
+
 func TestNumericAdd(t *testing.T) {
 	type testCase struct {
 		xStr, yStr string
@@ -536,6 +1083,10 @@ func TestNumericMul(t *testing.T) {
 		{"999999999", "-999999999", "-999999998000000001", false},
 		{"1999999999", "999999999", "<999999999999999999.999999999999999999999999999999999999", false},
 
+		// Carries that ripple through a unit already at 999999999
+		{"25024.000000000999999999999999999999999999", "11483.000000000000000000000000000999999999", "~287350592.000011483000000000000025023999963493", false},
+		{"97236.999999999999999999", "27299.000000000000000000999999999", "~2654472863.000000000000069937999902762999999999", false},
+
 		// NaN propagation
 		{"NaN", "1", "NaN", true},
 		{"1", "NaN", "NaN", true},
@@ -565,6 +1116,53 @@ func TestNumericMul(t *testing.T) {
 	}
 }
 
+func TestNumericSaturating(t *testing.T) {
+	ops := map[string]func(a, b Numeric) Numeric{
+		"AddSat": Numeric.AddSat,
+		"SubSat": Numeric.SubSat,
+		"MulSat": Numeric.MulSat,
+	}
+	tests := []struct {
+		op       string
+		a, b     string
+		expected string
+	}{
+		{"AddSat", "999999999999999999", "1", "999999999999999999.999999999999999999999999999999999999"},
+		{"AddSat", "-999999999999999999", "-1", "-999999999999999999.999999999999999999999999999999999999"},
+		{"AddSat", "1.5", "2.25", "3.75"},
+		{"SubSat", "-999999999999999999", "1", "-999999999999999999.999999999999999999999999999999999999"},
+		{"SubSat", "999999999999999999", "-5", "999999999999999999.999999999999999999999999999999999999"},
+		{"SubSat", "5", "7", "-2"},
+		{"MulSat", "1e10", "1e10", "999999999999999999.999999999999999999999999999999999999"},
+		{"MulSat", "-1e10", "1e10", "-999999999999999999.999999999999999999999999999999999999"},
+		{"MulSat", "1.5", "-4", "-6"},
+		{"AddSat", "1e19", "-1", "999999999999999999.999999999999999999999999999999999999"},
+		{"AddSat", "NaN", "1", "NaN"},
+		{"MulSat", "1e10", "NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.op+"_"+tc.a+"_"+tc.b, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			got := ops[tc.op](a, b)
+			if got.String() != tc.expected {
+				t.Errorf("%s(%q, %q) = %q, want %q", tc.op, tc.a, tc.b, got, tc.expected)
+			}
+			if !got.IsNaN() && got.HasOverflow() {
+				t.Errorf("%s(%q, %q) overflowed", tc.op, tc.a, tc.b)
+			}
+		})
+	}
+
+	if got := MaxValue().AddSat(One(false)); !got.IsIdentical(MaxValue()) {
+		t.Errorf("MaxValue().AddSat(1) = %q, want MaxValue", got)
+	}
+	if got := MinValue().SubSat(One(false)); !got.IsIdentical(MinValue()) {
+		t.Errorf("MinValue().SubSat(1) = %q, want MinValue", got)
+	}
+}
+
 func TestNumericDiv(t *testing.T) {
 	type testCase struct {
 		xStr, yStr string
@@ -651,24 +1249,365 @@ func TestNumericDiv(t *testing.T) {
 	}
 }
 
-func TestNumericTruncate(t *testing.T) {
-	type testCase struct {
-		input    string
-		expected string
+func TestNumericIsRepeating(t *testing.T) {
+	tests := []struct {
+		x, y     string
+		expected bool
+	}{
+		{"1", "3", true},
+		{"2", "7", true},
+		{"1", "8", false},
+		{"10", "4", false},
+		{"12", "3", false},
+		{"1", "1099511627776", true}, // 1/2^40 terminates after 40 places.
+		{"1", "0", false},
+		{"1e19", "3", false},
 	}
 
-	tests := []testCase{
-		// Positive decimals
-		{"123.456", "123"},
-		{"1.999999999", "1"},
-		{"0.000000001", "0"},
-		{"999999999.999999999", "999999999"},
+	for _, tc := range tests {
+		t.Run(tc.x+"/"+tc.y, func(t *testing.T) {
+			x, _ := FromString(tc.x)
+			y, _ := FromString(tc.y)
+			if got := x.Div(y).IsRepeating(); got != tc.expected {
+				t.Errorf("(%s / %s).IsRepeating() = %v, want %v", tc.x, tc.y, got, tc.expected)
+			}
+		})
+	}
 
-		// Negative decimals
-		{"-123.456", "-123"},
-		{"-1.999999999", "-1"},
-		{"-0.000000001", "0"},
-		{"-999999999.999999999", "-999999999"},
+	if FromInt(42).IsRepeating() {
+		t.Error("FromInt(42).IsRepeating() = true, want false")
+	}
+}
+
+func TestNumericDivRound(t *testing.T) {
+	tests := []struct {
+		x, y     string
+		places   int
+		mode     RoundMode
+		expected string
+	}{
+		{"1", "3", 4, RoundHalfUp, "0.3333"},
+		{"2", "3", 4, RoundHalfUp, "0.6667"},
+		{"2", "3", 4, RoundTowards, "0.6666"},
+		{"-2", "3", 2, RoundFloor, "-0.67"},
+		{"-2", "3", 2, RoundCeil, "-0.66"},
+		{"1", "8", 2, RoundHalfEven, "0.12"},
+		{"1", "8", 2, RoundHalfUp, "0.13"},
+		{"10", "4", 4, RoundHalfUp, "2.5"},
+		{"10", "4", 0, RoundHalfEven, "2"},
+		{"7", "7", 2, RoundHalfUp, "1"},
+		{"1", "3", 0, RoundHalfUp, "0"},
+		{"1", "3", 36, RoundHalfUp, "~0.333333333333333333333333333333333333"},
+		{"1", "4", 40, RoundHalfUp, "0.25"},
+		{"1", "0", 2, RoundHalfUp, "NaN"},
+		{"NaN", "3", 2, RoundHalfUp, "NaN"},
+		{"1", "3", -1, RoundHalfUp, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.x+"/"+tc.y, func(t *testing.T) {
+			x, _ := FromString(tc.x)
+			y, _ := FromString(tc.y)
+			if got := x.DivRound(y, tc.places, tc.mode); got.String() != tc.expected {
+				t.Errorf("DivRound(%q, %q, %d, %v) = %q, want %q", tc.x, tc.y, tc.places, tc.mode, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericPercentChange(t *testing.T) {
+	tests := []struct {
+		value, old string
+		change     string
+		ratio      string
+	}{
+		{"100", "80", "0.25", "1.25"},
+		{"80", "100", "-0.2", "0.8"},
+		{"50", "50", "0", "1"},
+		{"0", "50", "-1", "0"},
+		{"-30", "-20", "0.5", "1.5"},
+		{"30", "-20", "-2.5", "-1.5"},
+		{"110", "30", "~2.666666666666666666666666666666666666", "~3.666666666666666666666666666666666666"},
+		{"900000000000000000", "-900000000000000000", "-2", "-1"},
+		{"10", "0", "NaN", "NaN"},
+		{"0", "0", "NaN", "NaN"},
+		{"NaN", "1", "NaN", "NaN"},
+		{"1", "NaN", "NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value+"_"+tc.old, func(t *testing.T) {
+			n, _ := FromString(tc.value)
+			old, _ := FromString(tc.old)
+			if got := n.PercentChange(old); got.String() != tc.change {
+				t.Errorf("FromString(%q).PercentChange(%q) = %q, want %q", tc.value, tc.old, got, tc.change)
+			}
+			if got := n.RatioTo(old); got.String() != tc.ratio {
+				t.Errorf("FromString(%q).RatioTo(%q) = %q, want %q", tc.value, tc.old, got, tc.ratio)
+			}
+		})
+	}
+}
+
+func TestNumericDivChecked(t *testing.T) {
+	type testCase struct {
+		xStr, yStr string
+		expected   string
+		err        error
+	}
+
+	tests := []testCase{
+		{"1", "8", "0.125", nil},
+		{"1", "3", "~0.333333333333333333333333333333333333", nil},
+		{"-7.5", "2.5", "-3", nil},
+		{"999999999999999999", "0.5", "<999999999999999999.999999999999999999999999999999999999", nil},
+		{"1", "0", "NaN", ErrDivideByZero},
+		{"0", "0", "NaN", ErrDivideByZero},
+		{"1", "~0", "NaN", ErrDivideByZero},
+		{"NaN", "1", "NaN", ErrNotANumber},
+		{"1", "NaN", "NaN", ErrNotANumber},
+		{"NaN", "0", "NaN", ErrNotANumber},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.xStr+"/"+tc.yStr, func(t *testing.T) {
+			x, _ := FromString(tc.xStr)
+			y, _ := FromString(tc.yStr)
+			q, err := x.DivChecked(y)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("DivChecked(%q, %q) error = %v, want %v", tc.xStr, tc.yStr, err, tc.err)
+			}
+			if got := q.String(); got != tc.expected {
+				t.Errorf("DivChecked(%q, %q) = %q, want %q", tc.xStr, tc.yStr, got, tc.expected)
+			}
+			if err == nil && q != x.Div(y) {
+				t.Errorf("DivChecked(%q, %q) differs from Div", tc.xStr, tc.yStr)
+			}
+		})
+	}
+}
+
+func TestNumericMulAdd(t *testing.T) {
+	type testCase struct {
+		n, a, b  string
+		expected string
+		twoStep  string
+	}
+
+	tests := []testCase{
+		{"1", "2", "3", "7", "7"},
+		{"-10", "2", "3", "-4", "-4"},
+		{"10", "-2", "3", "4", "4"},
+		{"-1", "-2", "3", "-7", "-7"},
+		{"6", "2", "-3", "0", "0"},
+		{"0", "1.5", "1.5", "2.25", "2.25"},
+		// the product -1.5e-36 is truncated on its own before a separate Add.
+		{"1", "0.0000000000000000015", "-0.000000000000000001", "~0.999999999999999999999999999999999998", "~0.999999999999999999999999999999999999"},
+		{"-1", "-0.0000000000000000015", "0.000000000000000001", "~-1.000000000000000000000000000000000001", "~-1.000000000000000000000000000000000001"},
+		{"0.999999999999999999999999999999999999", "0.000000000000000001", "0.000000000000000001", "1", "1"},
+		{"-0.000000000000000000000000000000000001", "0.0000000000000000005", "0.000000000000000002", "0", "0"},
+		{"1", "0.3333333333333333333", "0.0000000000000000003", "~1.000000000000000000099999999999999999", "~1.000000000000000000099999999999999999"},
+		{"1", "0.00000000000000000015", "0.0000000000000000002", "~1", "~1"},
+		{"999999999999999999", "1", "1", "<999999999999999999.999999999999999999999999999999999999", "<999999999999999999.999999999999999999999999999999999999"},
+		{"1", "<1", "2", "<999999999999999999.999999999999999999999999999999999999", "<999999999999999999.999999999999999999999999999999999999"},
+		{"<1", "-1", "2", "<999999999999999999.999999999999999999999999999999999999", "<999999999999999999.999999999999999999999999999999999999"},
+		{"~1", "2", "3", "~7", "~7"},
+		{"NaN", "2", "3", "NaN", "NaN"},
+		{"1", "NaN", "3", "NaN", "NaN"},
+		{"1", "2", "NaN", "NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.n+"+"+tc.a+"*"+tc.b, func(t *testing.T) {
+			n, _ := FromString(tc.n)
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			if got := n.MulAdd(a, b).String(); got != tc.expected {
+				t.Errorf("MulAdd(%s, %s, %s) = %q, want %q", tc.n, tc.a, tc.b, got, tc.expected)
+			}
+			if got := n.Add(a.Mul(b)).String(); got != tc.twoStep {
+				t.Errorf("Add(Mul(%s, %s, %s)) = %q, want %q", tc.n, tc.a, tc.b, got, tc.twoStep)
+			}
+		})
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	type testCase struct {
+		a, b, c  string
+		expected string
+	}
+
+	tests := []testCase{
+		{"200", "3.5", "100", "7"},
+		{"-200", "3.5", "100", "-7"},
+		{"200", "-3.5", "-100", "7"},
+		{"1", "1", "3", "~0.333333333333333333333333333333333333"},
+		{"999999999999999999", "999999999999999999", "999999999999999999", "999999999999999999"},
+		{"123456789012345678", "1000", "10000", "12345678901234567.8"},
+		{"999999999999999999", "10", "5", "<999999999999999999.999999999999999999999999999999999999"},
+		{"-999999999999999999", "10", "5", "-<999999999999999999.999999999999999999999999999999999999"},
+		{"0.000000000000000000000000000000000001", "0.000000000000000001", "0.000000000000000001", "0.000000000000000000000000000000000001"},
+		{"0.000000000000000000000000000000000001", "0.5", "0.5", "0.000000000000000000000000000000000001"},
+		{"0.000000000000000000000000000000000003", "0.5", "1", "~0.000000000000000000000000000000000001"},
+		{"0", "999999999999999999", "7", "0"},
+		{"5", "7", "0", "NaN"},
+		{"NaN", "7", "1", "NaN"},
+		{"~2", "3", "1", "~6"},
+		{"<1", "2", "1", "<999999999999999999.999999999999999999999999999999999999"},
+		{"<1", "0", "1", "0"},
+		{"~0", "<1", "1", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"*"+tc.b+"/"+tc.c, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			c, _ := FromString(tc.c)
+			if got := MulDiv(a, b, c).String(); got != tc.expected {
+				t.Errorf("MulDiv(%s, %s, %s) = %q, want %q", tc.a, tc.b, tc.c, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericDivTerminates(t *testing.T) {
+	type testCase struct {
+		xStr, yStr string
+		expected   string
+		exact      bool
+	}
+
+	tests := []testCase{
+		{"1", "8", "0.125", true},
+		{"1", "3", "~0.333333333333333333333333333333333333", false},
+		{"-10", "4", "-2.5", true},
+		{"2", "7", "~0.285714285714285714285714285714285714", false},
+		{"1", "1024", "0.0009765625", true},
+		{"1", "0", "NaN", false},
+		{"0", "0", "NaN", false},
+		{"0", "5", "0", true},
+		{"~1", "8", "~0.125", false},
+		{"999999999999999999", "0.1", "<999999999999999999.999999999999999999999999999999999999", false},
+		{"0.000000000000000000000000000000000001", "10", "~0", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.xStr+"/"+tc.yStr, func(t *testing.T) {
+			x, _ := FromString(tc.xStr)
+			y, _ := FromString(tc.yStr)
+
+			q, exact := x.DivTerminates(y)
+			if got := q.String(); got != tc.expected {
+				t.Errorf("DivTerminates(%q, %q) = %q, want %q", tc.xStr, tc.yStr, got, tc.expected)
+			}
+			if exact != tc.exact {
+				t.Errorf("DivTerminates(%q, %q) exact = %v, want %v", tc.xStr, tc.yStr, exact, tc.exact)
+			}
+		})
+	}
+}
+
+func TestNumericPow(t *testing.T) {
+	type testCase struct {
+		input    string
+		exp      int
+		expected string
+	}
+
+	tests := []testCase{
+		{"2", 10, "1024"},
+		{"2", 0, "1"},
+		{"0", 0, "1"},
+		{"0", 5, "0"},
+		{"1.5", 2, "2.25"},
+		{"-2", 3, "-8"},
+		{"-2", 4, "16"},
+		{"10", -3, "0.001"},
+		{"-2", -1, "-0.5"},
+		{"3", -1, "~0.333333333333333333333333333333333333"},
+		{"1.01", 16, "1.17257864492369852051862561201601"},
+		{"2", 59, "576460752303423488"},
+		{"1000000", 4, "<999999999999999999.999999999999999999999999999999999999"},
+		{"-1000000", 3, "-<999999999999999999.999999999999999999999999999999999999"},
+		{"1000000", 6, "<999999999999999999.999999999999999999999999999999999999"},
+		{"2", -200, "~0"},
+		{"0.000000001", 5, "~0"},
+		{"0", -1, "NaN"},
+		{"NaN", 2, "NaN"},
+		{"NaN", 0, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s^%d", tc.input, tc.exp), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("Invalid input %q: %v", tc.input, err)
+			}
+			if got := n.Pow(tc.exp).String(); got != tc.expected {
+				t.Errorf("Pow(%q, %d) = %q, want %q", tc.input, tc.exp, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericSqrt(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+
+	tests := []testCase{
+		{"0", "0"},
+		{"1", "1"},
+		{"4", "2"},
+		{"144", "12"},
+		{"2.25", "1.5"},
+		{"0.0001", "0.01"},
+		{"999999998000000001", "999999999"},
+		{"0.000000000000000000000000000000000001", "0.000000000000000001"},
+		{"2", "~1.414213562373095048801688724209698078"},
+		{"3", "~1.732050807568877293527446341505872366"},
+		{"0.5", "~0.707106781186547524400844362104849039"},
+		{"999999999999999999.999999999999999999999999999999999999", "~999999999.999999999999999999999999999999999999"},
+		{"~4", "~2"},
+		{"~0", "~0"},
+		{"-4", "NaN"},
+		{"NaN", "NaN"},
+		{"<1", "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("Invalid input %q: %v", tc.input, err)
+			}
+			if got := n.Sqrt().String(); got != tc.expected {
+				t.Errorf("Sqrt(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericTruncate(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+	}
+
+	tests := []testCase{
+		// Positive decimals
+		{"123.456", "123"},
+		{"1.999999999", "1"},
+		{"0.000000001", "0"},
+		{"999999999.999999999", "999999999"},
+
+		// Negative decimals
+		{"-123.456", "-123"},
+		{"-1.999999999", "-1"},
+		{"-0.000000001", "0"},
+		{"-999999999.999999999", "-999999999"},
 
 		// Whole numbers
 		{"0", "0"},
@@ -692,7 +1631,7 @@ func TestNumericTruncate(t *testing.T) {
 				t.Fatalf("FromString(%q): %v", tc.input, err)
 			}
 
-			got := n.Truncate(Numeric{}).String()
+			got := n.Truncate().String()
 			if got != tc.expected {
 				t.Errorf("Truncate(%q) = %q, want %q", tc.input, got, tc.expected)
 			}
@@ -700,6 +1639,134 @@ func TestNumericTruncate(t *testing.T) {
 	}
 }
 
+func TestNumericShift(t *testing.T) {
+	tests := []struct {
+		input  string
+		places int
+		left   string
+		right  string
+	}{
+		{"0.5", 3, "500", "0.0005"},
+		{"500", 3, "500000", "0.5"},
+		{"123.456", 2, "12345.6", "1.23456"},
+		{"123.456", 3, "123456", "0.123456"},
+		{"123.456", 5, "12345600", "0.00123456"},
+		{"-0.00125", 4, "-12.5", "-0.000000125"},
+		{"0.005", 19, "50000000000000000", "0.0000000000000000000005"},
+		{"1.5", 0, "1.5", "1.5"},
+		{"1.5", -1, "0.15", "15"},
+		{"0", 40, "0", "0"},
+		{"~2.5", 1, "~25", "~0.25"},
+		{"1", 17, "100000000000000000", "0.00000000000000001"},
+		{"1", 18, "<999999999999999999.999999999999999999999999999999999999", "0.000000000000000001"},
+		{"1", 36, "<999999999999999999.999999999999999999999999999999999999", "0.000000000000000000000000000000000001"},
+		{"-1", 37, "-<999999999999999999.999999999999999999999999999999999999", "~-0"},
+		{"15", 37, "<999999999999999999.999999999999999999999999999999999999", "~0.000000000000000000000000000000000001"},
+		{"123456789012345678.123456789", 0, "123456789012345678.123456789", "123456789012345678.123456789"},
+		{"123456789012345678.123456789", 18, "<999999999999999999.999999999999999999999999999999999999", "0.123456789012345678123456789"},
+		{"0.000000000000000000000000000000000001", 53, "100000000000000000", "~0"},
+		{"5", 108, "<999999999999999999.999999999999999999999999999999999999", "~0"},
+		{"5", math.MaxInt, "<999999999999999999.999999999999999999999999999999999999", "~0"},
+		{"5", math.MinInt, "~0", "<999999999999999999.999999999999999999999999999999999999"},
+		{"-5", math.MaxInt, "-<999999999999999999.999999999999999999999999999999999999", "~-0"},
+		{"-5", math.MinInt, "~-0", "-<999999999999999999.999999999999999999999999999999999999"},
+		{"0.000000000000000000000000000000000001", math.MaxInt, "<999999999999999999.999999999999999999999999999999999999", "~0"},
+		{"999999999999999999", math.MinInt, "~0", "<999999999999999999.999999999999999999999999999999999999"},
+		{"0", math.MaxInt, "0", "0"},
+		{"0", math.MinInt, "0", "0"},
+		{"NaN", 2, "NaN", "NaN"},
+		{"<1", 2, "<999999999999999999.999999999999999999999999999999999999", "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s_%d", tc.input, tc.places), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q): %v", tc.input, err)
+			}
+			if got := n.ShiftLeft(tc.places).String(); got != tc.left {
+				t.Errorf("ShiftLeft(%q, %d) = %q, want %q", tc.input, tc.places, got, tc.left)
+			}
+			if got := n.ShiftRight(tc.places).String(); got != tc.right {
+				t.Errorf("ShiftRight(%q, %d) = %q, want %q", tc.input, tc.places, got, tc.right)
+			}
+		})
+	}
+}
+
+func TestNumericFrac(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.25", "0.25"},
+		{"-1.25", "-0.25"},
+		{"0.75", "0.75"},
+		{"-0.75", "-0.75"},
+		{"123456789012345678.000000000000000000000000000000000001", "0.000000000000000000000000000000000001"},
+		{"~-3.5", "~-0.5"},
+		{"42", "0"},
+		{"-42", "0"},
+		{"0", "0"},
+		{"<1", "0"},
+		{"-<1", "0"},
+		{"NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q): %v", tc.input, err)
+			}
+			frac := n.Frac()
+			if got := frac.String(); got != tc.expected {
+				t.Errorf("Frac(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+			if n.IsNaN() || n.HasOverflow() {
+				return
+			}
+			if sum := n.Truncate().Add(frac); !sum.IsIdentical(n) {
+				t.Errorf("Truncate(%q) + Frac = %q, want %q", tc.input, sum, n)
+			}
+		})
+	}
+}
+
+func TestNumericTruncateTo(t *testing.T) {
+	tests := []struct {
+		input    string
+		places   int
+		expected string
+	}{
+		{"1.239", 2, "1.23"},
+		{"1.239", 0, "1"},
+		{"1.239", 5, "1.239"},
+		{"-1.239", 2, "-1.23"},
+		{"-1.239", 0, "-1"},
+		{"-0.001", 2, "0"},
+		{"123456789.123456789123", 12, "123456789.123456789123"},
+		{"0.123456789123456789123456789123456789", 35, "0.12345678912345678912345678912345678"},
+		{"~1.5", 36, "1.5"},
+		{"1.239", -1, "NaN"},
+		{"-1.239", -2, "NaN"},
+		{"NaN", 2, "NaN"},
+		{"-<1", 2, "-<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s_%d", tc.input, tc.places), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q): %v", tc.input, err)
+			}
+			if got := n.TruncateTo(tc.places).String(); got != tc.expected {
+				t.Errorf("TruncateTo(%q, %d) = %q, want %q", tc.input, tc.places, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestNumericDivRem(t *testing.T) {
 	type testCase struct {
 		xStr, yStr string
@@ -767,6 +1834,171 @@ func TestNumericDivRem(t *testing.T) {
 	}
 }
 
+func TestNumericIntDiv(t *testing.T) {
+	tests := []struct {
+		xStr, yStr string
+		expected   string
+	}{
+		{"7", "2", "3"},
+		{"-7", "2", "-4"},
+		{"7", "-2", "-4"},
+		{"-7", "-2", "3"},
+		{"6", "-2", "-3"},
+		{"0", "-2", "0"},
+		{"1", "3", "0"},
+		{"-1", "3", "-1"},
+		{"7.5", "2", "3"},
+		{"-7.5", "2.5", "-3"},
+		{"-7.6", "2.5", "-4"},
+		{"999999999999999999", "1", "999999999999999999"},
+		{"-999999999999999999", "1000000000", "-1000000000"},
+		{"999999999999999999", "0.1", "NaN"},
+		{"1", "0", "NaN"},
+		{"NaN", "1", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.xStr+"_"+tc.yStr, func(t *testing.T) {
+			x, _ := FromString(tc.xStr)
+			y, _ := FromString(tc.yStr)
+			if got := x.IntDiv(y).String(); got != tc.expected {
+				t.Errorf("IntDiv(%q, %q) = %q, want %q", tc.xStr, tc.yStr, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericDivRemIntegerPath(t *testing.T) {
+	values := []int64{0, 1, -1, 7, -7, 999999999, 1000000000, -123456789012345678, 999999999999999999}
+	for _, xi := range values {
+		for _, yi := range values {
+			if yi == 0 {
+				continue
+			}
+			x, y := FromInt(xi), FromInt(yi)
+			q, r := x.DivRem(y)
+			if q.Int() != xi/yi || r.Int() != xi%yi || q.HasUnderflow() || r.HasUnderflow() {
+				t.Errorf("DivRem(%d, %d) = %s, %s, want %d, %d", xi, yi, q, r, xi/yi, xi%yi)
+			}
+			if (q.IsZero() && q.z.isNeg()) || (r.IsZero() && r.z.isNeg()) {
+				t.Errorf("DivRem(%d, %d) returned a negative zero", xi, yi)
+			}
+		}
+	}
+}
+
+func TestNumericMod(t *testing.T) {
+	type testCase struct {
+		xStr, yStr string
+		want       string
+	}
+
+	tests := []testCase{
+		{"10", "3", "1"},
+		{"9", "3", "0"},
+		{"1", "3", "1"},
+		{"-7", "3", "-1"},
+		{"7", "-3", "1"},
+		{"-7", "-3", "-1"},
+		{"-9", "3", "0"},
+		{"5.5", "2", "1.5"},
+		{"-5.5", "2", "-1.5"},
+		{"7.25", "2.5", "2.25"},
+		{"1", "0.3", "0.1"},
+		{"0.000000000000000000000000000000000007", "0.000000000000000000000000000000000002", "0.000000000000000000000000000000000001"},
+		{"0", "5", "0"},
+		{"5", "0", "NaN"},
+		{"NaN", "1", "NaN"},
+		{"1", "NaN", "NaN"},
+		{"1e17", "0.000000001", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.xStr+" mod "+tc.yStr, func(t *testing.T) {
+			x, err1 := FromString(tc.xStr)
+			y, err2 := FromString(tc.yStr)
+			if err1 != nil || err2 != nil {
+				t.Fatalf("invalid input: %v / %v", err1, err2)
+			}
+
+			if got := x.Mod(y).String(); got != tc.want {
+				t.Errorf("Mod(%q, %q) = %q, want %q", tc.xStr, tc.yStr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNumericAddMod(t *testing.T) {
+	tests := []struct {
+		a, b, modulus string
+		expected      string
+	}{
+		{"5", "4", "7", "2"},
+		{"3", "4", "7", "0"},
+		{"0", "0", "7", "0"},
+		{"-3", "1", "7", "5"},
+		{"-10", "-10", "7", "1"},
+		{"5", "4", "-7", "2"},
+		{"1.5", "6", "7", "0.5"},
+		{"23", "30", "7", "4"},
+		// the raw sums overflow before reduction.
+		{"999999999999999998", "999999999999999998", "999999999999999999", "999999999999999997"},
+		{"999999999999999999", "999999999999999999", "1000000000", "999999998"},
+		{"999999999999999998", "1", "999999999999999999", "0"},
+		{"5", "4", "0", "NaN"},
+		{"5", "4", "7.5", "NaN"},
+		{"5", "4", "NaN", "NaN"},
+		{"NaN", "4", "7", "NaN"},
+		{"1e19", "4", "7", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b+"_"+tc.modulus, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			m, _ := FromString(tc.modulus)
+			if got := a.AddMod(b, m); got.String() != tc.expected {
+				t.Errorf("AddMod(%q, %q, %q) = %q, want %q", tc.a, tc.b, tc.modulus, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericDivisibleBy(t *testing.T) {
+	type testCase struct {
+		xStr, yStr string
+		expected   bool
+	}
+
+	tests := []testCase{
+		{"10", "5", true},
+		{"10", "3", false},
+		{"-10", "5", true},
+		{"10", "-2.5", true},
+		{"0.3", "0.1", true},
+		{"0.35", "0.1", false},
+		{"1", "0.333333333333333333333333333333333333", false},
+		{"0", "7", true},
+		{"7", "0", false},
+		{"0", "0", false},
+		{"NaN", "1", false},
+		{"1", "NaN", false},
+		{"~10", "5", false},
+		{"999999999999999999", "0.000000000000000000000000000000000001", false},
+		{"123456789.123456789", "0.000000001", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.xStr+"_"+tc.yStr, func(t *testing.T) {
+			x, _ := FromString(tc.xStr)
+			y, _ := FromString(tc.yStr)
+			if got := x.DivisibleBy(y); got != tc.expected {
+				t.Errorf("DivisibleBy(%q, %q) = %v, want %v", tc.xStr, tc.yStr, got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestNumericNeg(t *testing.T) {
 	type testCase struct {
 		input     string
@@ -823,6 +2055,42 @@ func TestNumericNeg(t *testing.T) {
 	}
 }
 
+func TestNumericNegSigned(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		negZero  bool
+	}{
+		{"0", "0", true},
+		{"-0", "0", false},
+		{"1.5", "-1.5", false},
+		{"-1.5", "1.5", false},
+		{"~0", "~-0", true},
+		{"NaN", "NaN", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			neg := n.NegSigned()
+			if got := neg.String(); got != tc.expected {
+				t.Errorf("FromString(%q).NegSigned() = %q, want %q", tc.input, got, tc.expected)
+			}
+			f := neg.Float64()
+			if negZero := f == 0 && math.Signbit(f); negZero != tc.negZero {
+				t.Errorf("FromString(%q).NegSigned().Float64() = %g, negative zero %v, want %v", tc.input, f, negZero, tc.negZero)
+			}
+			if back := neg.NegSigned(); !back.IsIdentical(n) && !n.IsNaN() {
+				t.Errorf("FromString(%q).NegSigned().NegSigned() = %q, want %q", tc.input, back, n)
+			}
+		})
+	}
+
+	if f := Zero.Neg().Float64(); math.Signbit(f) {
+		t.Errorf("Zero.Neg().Float64() = %g, want positive zero", f)
+	}
+}
+
 func TestNumericAbs(t *testing.T) {
 	type testCase struct {
 		input     string
@@ -946,6 +2214,81 @@ func TestNumericSign(t *testing.T) {
 	}
 }
 
+func TestNumericSign3(t *testing.T) {
+	tests := []struct {
+		input string
+		sign  int
+		sign3 int
+	}{
+		{"NaN", 0, 0},
+		{"0", 1, 0},
+		{"0.000", 1, 0},
+		{"~0", 1, 1},
+		{"~-0", -1, -1},
+		{"0.000000000000000000000000000000000001", 1, 1},
+		{"1", 1, 1},
+		{"-1", -1, -1},
+		{"-0.000000001", -1, -1},
+		{"1e19", 1, 1},
+		{"-1e19", -1, -1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			if got := n.Sign3(); got != tc.sign3 {
+				t.Errorf("FromString(%q).Sign3() = %d, want %d", tc.input, got, tc.sign3)
+			}
+			if got := n.Sign(); got != tc.sign {
+				t.Errorf("FromString(%q).Sign() = %d, want %d", tc.input, got, tc.sign)
+			}
+		})
+	}
+
+	if got := Zero.NegSigned().Sign3(); got != 0 {
+		t.Errorf("Zero.NegSigned().Sign3() = %d, want 0", got)
+	}
+}
+
+func TestNumericPredicates(t *testing.T) {
+	type testCase struct {
+		input                       string
+		positive, negative, integer bool
+	}
+
+	tests := []testCase{
+		{"0", false, false, true},
+		{"~0", false, false, false},
+		{"~-0", false, false, false},
+		{"1", true, false, true},
+		{"-1", false, true, true},
+		{"0.000000000000000000000000000000000001", true, false, false},
+		{"-0.000000000000000000000000000000000001", false, true, false},
+		{"123.5", true, false, false},
+		{"-999999999999999999", false, true, true},
+		{"1000000000.000000001", true, false, false},
+		{"~7", true, false, false},
+		{"<1", true, false, false},
+		{"-<1", false, true, false},
+		{"NaN", false, false, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			if got := n.IsPositive(); got != tc.positive {
+				t.Errorf("IsPositive(%q) = %v, want %v", tc.input, got, tc.positive)
+			}
+			if got := n.IsNegative(); got != tc.negative {
+				t.Errorf("IsNegative(%q) = %v, want %v", tc.input, got, tc.negative)
+			}
+			if got := n.IsInteger(); got != tc.integer {
+				t.Errorf("IsInteger(%q) = %v, want %v", tc.input, got, tc.integer)
+			}
+		})
+	}
+}
+
 func TestNumericFlags(t *testing.T) {
 	type testCase struct {
 		input        string
@@ -1011,6 +2354,13 @@ func TestNumericComparisons(t *testing.T) {
 		{"0", "0", 0, true, false, true, false, true},
 		{"1.5", "1.5", 0, true, false, true, false, true},
 		{"-100", "-100", 0, true, false, true, false, true},
+		{"-0", "0", 0, true, false, true, false, true},
+		{"0", "-0", 0, true, false, true, false, true},
+		{"-0", "-0", 0, true, false, true, false, true},
+		{"-0", "0.000000001", -1, false, true, true, false, false},
+		{"-0", "-0.000000001", 1, false, false, false, true, true},
+		{"~-0", "-0", -1, false, true, true, false, false},
+		{"-0", "~0", -1, false, true, true, false, false},
 		{"1e9", "2e9", -1, false, true, true, false, false},
 		{"1-e9", "2-e9", -1, false, true, true, false, false},
 		{"1-e18", "2-e18", -1, false, true, true, false, false},
@@ -1065,6 +2415,197 @@ func TestNumericComparisons(t *testing.T) {
 	}
 }
 
+func TestNumericCmpOrdered(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		want    int
+		ordered bool
+	}{
+		{"1", "2", -1, true},
+		{"2", "1", 1, true},
+		{"-1.5", "-1.5", 0, true},
+		{"~0.5", "0.4", 1, true},
+		{"NaN", "1", 0, false},
+		{"1", "NaN", 0, false},
+		{"NaN", "NaN", 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			got, ordered := a.CmpOrdered(b)
+			if ordered != tc.ordered {
+				t.Fatalf("CmpOrdered(%q, %q) ordered = %v, want %v", tc.a, tc.b, ordered, tc.ordered)
+			}
+			if ordered && (got != tc.want || got != a.Cmp(b)) {
+				t.Errorf("CmpOrdered(%q, %q) = %d, want %d (Cmp %d)", tc.a, tc.b, got, tc.want, a.Cmp(b))
+			}
+		})
+	}
+}
+
+func TestNumericNormalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"3.1400", "3.14"},
+		{"3.14", "3.14"},
+		{"100.000", "100"},
+		{"-0.50", "-0.5"},
+		{"0.000", "0"},
+		{"-0", "0"},
+		{"~-0", "~-0"},
+		{"1e19", "<999999999999999999.999999999999999999999999999999999999"},
+		{"NaN", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			got := n.Normalize()
+			if got.String() != tc.expected {
+				t.Errorf("FromString(%q).Normalize() = %q, want %q", tc.input, got, tc.expected)
+			}
+			want, _ := FromString(tc.expected)
+			if !got.IsIdentical(want) {
+				t.Errorf("FromString(%q).Normalize() is not identical to FromString(%q)", tc.input, tc.expected)
+			}
+			if !n.IsUnderOverNaN() && !got.IsEqual(n) {
+				t.Errorf("FromString(%q).Normalize() is not equal to the original", tc.input)
+			}
+		})
+	}
+
+	if got := Zero.NegSigned().Normalize(); !got.IsIdentical(Zero) {
+		t.Errorf("Zero.NegSigned().Normalize() = %q, want identical to Zero", got)
+	}
+}
+
+func TestNumericCmpMagnitude(t *testing.T) {
+	tests := []struct {
+		a, b      string
+		cmp       int
+		magnitude int
+	}{
+		{"~0.5", "0.5", 1, 0},
+		{"0.5", "~0.5", -1, 0},
+		{"~-0.5", "-0.5", -1, 0},
+		{"~0.5", "0.6", -1, -1},
+		{"~0.5", "0.4", 1, 1},
+		{"~0", "0", 1, 0},
+		{"~-0", "0", -1, 0},
+		{"-0", "0", 0, 0},
+		{"1", "2", -1, -1},
+		{"-1", "1", -1, -1},
+		{"-2", "-1", -1, -1},
+		{"NaN", "-1e19", -1, -1},
+		{"1", "NaN", 1, 1},
+		{"NaN", "NaN", -1, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			if got := a.Cmp(b); got != tc.cmp {
+				t.Errorf("Cmp(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.cmp)
+			}
+			if got := a.CmpMagnitude(b); got != tc.magnitude {
+				t.Errorf("CmpMagnitude(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.magnitude)
+			}
+		})
+	}
+
+	over, under := Numeric{z: overflow(false)}, Numeric{z: overflow(true)}
+	overflows := []struct {
+		a, b     Numeric
+		expected int
+	}{
+		{over, MaxValue(), 0},
+		{under, MinValue(), 0},
+		{over, One(false), 1},
+		{under, One(true), -1},
+		{under, over, -1},
+	}
+	for _, tc := range overflows {
+		if got := tc.a.CmpMagnitude(tc.b); got != tc.expected {
+			t.Errorf("CmpMagnitude(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.expected)
+		}
+	}
+}
+
+func TestNumericHash(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{"3.00", "3", true},
+		{"3", "+3.000000000000000000000000000000000000", true},
+		{"0.5", "5e-1", true},
+		{"0", "-0", true},
+		{"3", "-3", false},
+		{"3", "3.000000000000000000000000000000000001", false},
+		{"1000000000", "1", false},
+		{"~3", "3", false},
+		{"0.1", "0.2", false},
+		{"NaN", "NaN", true},
+		{"NaN", "0", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			if same := a.Hash() == b.Hash(); same != tc.same {
+				t.Errorf("Hash(%q) == Hash(%q) is %v, want %v", tc.a, tc.b, same, tc.same)
+			}
+			if a.IsEqual(b) && a.Hash() != b.Hash() {
+				t.Errorf("%q and %q are equal but hash differently", tc.a, tc.b)
+			}
+		})
+	}
+
+	if a := testing.AllocsPerRun(100, func() { _ = FromInt(42).Hash() }); a != 0 {
+		t.Errorf("Hash allocs = %v, want 0", a)
+	}
+}
+
+func TestNumericIsIdentical(t *testing.T) {
+	type testCase struct {
+		a, b      string
+		identical bool
+		equal     bool
+	}
+
+	tests := []testCase{
+		{"1.5", "1.5", true, true},
+		{"1.5", "1.50", true, true},
+		{"1.5", "-1.5", false, false},
+		{"NaN", "NaN", true, false},
+		{"<1", "<2", true, false},
+		{"<1", "-<1", false, false},
+		{"~0.5", "~0.5", true, false},
+		{"~0.5", "0.5", false, false},
+		{"~-0", "~0", false, false},
+		{"NaN", "0", false, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.a+"_"+tc.b, func(t *testing.T) {
+			a, _ := FromString(tc.a)
+			b, _ := FromString(tc.b)
+			if got := a.IsIdentical(b); got != tc.identical {
+				t.Errorf("IsIdentical(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.identical)
+			}
+			if got := a.IsEqual(b); got != tc.equal {
+				t.Errorf("IsEqual(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.equal)
+			}
+		})
+	}
+}
+
 func TestMarshalUnmarshalText(t *testing.T) {
 	type testCase struct {
 		input    string
@@ -1104,25 +2645,180 @@ func TestMarshalUnmarshalText(t *testing.T) {
 				t.Fatalf("MarshalText(%q) failed: %v", tc.input, err)
 			}
 
-			// Unmarshal back to Numeric
-			var roundTripped Numeric
-			err = roundTripped.UnmarshalText(text)
-			if err != nil {
-				t.Fatalf("UnmarshalText(%q) failed: %v", string(text), err)
-			}
+			// Unmarshal back to Numeric
+			var roundTripped Numeric
+			err = roundTripped.UnmarshalText(text)
+			if err != nil {
+				t.Fatalf("UnmarshalText(%q) failed: %v", string(text), err)
+			}
+
+			// Check result
+			if tc.expected == "NaN" {
+				if !roundTripped.IsNaN() {
+					t.Errorf("Expected NaN from %q, got %q", string(text), roundTripped.String())
+				}
+			} else {
+				got := roundTripped.String()
+				if got != tc.expected {
+					t.Errorf("Round-trip failed: %q → %q → %q (want %q)", tc.input, string(text), got, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextWhitespace(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{"123.45", true},
+		{" 123.45", true},
+		{"123.45 ", true},
+		{"  123.45  ", true},
+		{"\t123.45", true},
+		{"123.45\t", true},
+		{"\n123.45\n", true},
+		{"\r\n123.45\r\n", true},
+		{" \t\n123.45\n\t ", true},
+		{"\u00a0123.45\u2003", true},
+		{"1 23.45", false},
+		{"123 .45", false},
+		{"123.\t45", false},
+		{" 1\n23.45 ", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(strconv.Quote(tc.input), func(t *testing.T) {
+			var n Numeric
+			err := n.UnmarshalText([]byte(tc.input))
+			if !tc.valid {
+				if err == nil {
+					t.Errorf("UnmarshalText(%q) = %q, want error", tc.input, n)
+				}
+				return
+			}
+			if err != nil || n.String() != "123.45" {
+				t.Errorf("UnmarshalText(%q) = %q, %v, want 123.45", tc.input, n, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONWhitespace(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{`"1.5"`, true},
+		{`" 1.5 "`, true},
+		{` 1.5 `, true},
+		{"\t1.5\n", true},
+		{`"1 .5"`, false},
+		{`1 .5`, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(strconv.Quote(tc.input), func(t *testing.T) {
+			var n Numeric
+			err := n.UnmarshalJSON([]byte(tc.input))
+			if !tc.valid {
+				if err == nil {
+					t.Errorf("UnmarshalJSON(%s) = %q, want error", tc.input, n)
+				}
+				return
+			}
+			if err != nil || n.String() != "1.5" {
+				t.Errorf("UnmarshalJSON(%s) = %q, %v, want 1.5", tc.input, n, err)
+			}
+
+			// the same input as a struct field through encoding/json.
+			var v struct{ N Numeric }
+			if err := json.Unmarshal([]byte(`{"N":`+tc.input+`}`), &v); err != nil || v.N.String() != "1.5" {
+				t.Errorf("json.Unmarshal(%s) = %q, %v, want 1.5", tc.input, v.N, err)
+			}
+		})
+	}
+}
+
+func TestEmptyNaNMarshalUnmarshalText(t *testing.T) {
+	type testCase struct {
+		input string
+		text  string
+	}
+
+	tests := []testCase{
+		{"NaN", ""},
+		{"", ""},
+		{"0", "0"},
+		{"-123.456", "-123.456"},
+		{"~0.5", "~0.5"},
+		{"<1", "<999999999999999999.999999999999999999999999999999999999"},
+	}
+
+	for _, tc := range tests {
+		t.Run("EmptyNaN_"+tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			text, err := EmptyNaN{n}.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText(%q) failed: %v", tc.input, err)
+			}
+			if string(text) != tc.text {
+				t.Errorf("MarshalText(%q) = %q, want %q", tc.input, text, tc.text)
+			}
+
+			var e EmptyNaN
+			if err := e.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText(%q) failed: %v", text, err)
+			}
+			if e.IsNaN() != n.IsNaN() || (!n.IsNaN() && e.String() != n.String()) {
+				t.Errorf("round trip %q → %q → %q", tc.input, text, e.String())
+			}
+		})
+	}
+}
+
+func TestEmptyNaNJSON(t *testing.T) {
+	t.Cleanup(func() { SetJSONNaNMode(NaNAsString) })
+	type row struct {
+		Amount EmptyNaN
+	}
+	tests := []struct {
+		input string
+		json  string
+	}{
+		{"NaN", `{"Amount":""}`},
+		{"1.25", `{"Amount":"1.25"}`},
+		{"~-0.5", `{"Amount":"~-0.5"}`},
+	}
+
+	for _, mode := range []NaNMode{NaNAsString, NaNAsNull, NaNAsError} {
+		SetJSONNaNMode(mode)
+		for _, tc := range tests {
+			t.Run(mode.String()+"_"+tc.input, func(t *testing.T) {
+				n, _ := FromString(tc.input)
+				data, err := json.Marshal(row{EmptyNaN{n}})
+				if err != nil {
+					t.Fatalf("Marshal(%q) failed: %v", tc.input, err)
+				}
+				if string(data) != tc.json {
+					t.Errorf("Marshal(%q) = %s, want %s", tc.input, data, tc.json)
+				}
 
-			// Check result
-			if tc.expected == "NaN" {
-				if !roundTripped.IsNaN() {
-					t.Errorf("Expected NaN from %q, got %q", string(text), roundTripped.String())
+				var got row
+				if err := json.Unmarshal(data, &got); err != nil {
+					t.Fatalf("Unmarshal(%s) failed: %v", data, err)
 				}
-			} else {
-				got := roundTripped.String()
-				if got != tc.expected {
-					t.Errorf("Round-trip failed: %q → %q → %q (want %q)", tc.input, string(text), got, tc.expected)
+				if !got.Amount.IsIdentical(n) {
+					t.Errorf("round trip of %q = %q", tc.input, got.Amount)
 				}
-			}
-		})
+			})
+		}
+	}
+
+	var e EmptyNaN
+	if err := json.Unmarshal([]byte("null"), &e); err != nil || !e.IsNaN() {
+		t.Errorf("Unmarshal(null) = %q, %v, want NaN", e, err)
 	}
 }
 
@@ -1211,6 +2907,199 @@ func TestMarshalUnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestJSONNaNMode(t *testing.T) {
+	t.Cleanup(func() { SetJSONNaNMode(NaNAsString) })
+
+	tests := []struct {
+		mode     NaNMode
+		name     string
+		expected string
+		err      error
+	}{
+		{NaNAsString, "string", `"NaN"`, nil},
+		{NaNAsNull, "null", `null`, nil},
+		{NaNAsError, "error", "", ErrNotANumber},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			SetJSONNaNMode(tc.mode)
+			if got := JSONNaNMode(); got != tc.mode || got.String() != tc.name {
+				t.Errorf("JSONNaNMode() = %v, want %v", got, tc.name)
+			}
+
+			data, err := NaN().MarshalJSON()
+			if !errors.Is(err, tc.err) || string(data) != tc.expected {
+				t.Errorf("NaN().MarshalJSON() = %q, %v, want %q, %v", data, err, tc.expected, tc.err)
+			}
+			if data, err := FromInt(5).MarshalJSON(); err != nil || string(data) != `"5"` {
+				t.Errorf("FromInt(5).MarshalJSON() = %q, %v, want %q", data, err, `"5"`)
+			}
+
+			for _, in := range []string{`null`, `"NaN"`} {
+				n := One(false)
+				if err := n.UnmarshalJSON([]byte(in)); err != nil || !n.IsNaN() {
+					t.Errorf("UnmarshalJSON(%s) = %q, %v, want NaN", in, n, err)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONNaNModeStruct(t *testing.T) {
+	t.Cleanup(func() { SetJSONNaNMode(NaNAsString) })
+	SetJSONNaNMode(NaNAsNull)
+
+	type record struct {
+		Price Numeric `json:"price"`
+	}
+	data, err := json.Marshal(record{Price: NaN()})
+	if err != nil || string(data) != `{"price":null}` {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, `{"price":null}`)
+	}
+	var r record
+	if err := json.Unmarshal(data, &r); err != nil || !r.Price.IsNaN() {
+		t.Errorf("json.Unmarshal(%s) = %q, %v, want NaN", data, r.Price, err)
+	}
+}
+
+func TestUnmarshalJSONStrict(t *testing.T) {
+	t.Cleanup(func() { SetJSONStrict(false) })
+
+	over := "<999999999999999999.999999999999999999999999999999999999"
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{`123.456789012345678901234567890`, "123.45678901234567890123456789", nil},
+		{`"123.456789012345678901234567890"`, "123.45678901234567890123456789", nil},
+		{`12345678901234567890.5`, over, ErrOverflow},
+		{`"12345678901234567890.5"`, over, ErrOverflow},
+		{`-1e19`, "-" + over, ErrOverflow},
+		{`0.0000000000000000000000000000000000001`, "~0", ErrUnderflow},
+		{`"~1.5"`, "~1.5", ErrUnderflow},
+		{`"NaN"`, "NaN", nil},
+		{`null`, "NaN", nil},
+	}
+
+	for _, strict := range []bool{false, true} {
+		SetJSONStrict(strict)
+		if JSONStrict() != strict {
+			t.Fatalf("JSONStrict() = %v, want %v", JSONStrict(), strict)
+		}
+		for _, tc := range tests {
+			var n Numeric
+			err := n.UnmarshalJSON([]byte(tc.input))
+			if strict && tc.err != nil {
+				if !errors.Is(err, tc.err) || !errors.Is(err, ErrValueOutOfRange) {
+					t.Errorf("strict UnmarshalJSON(%s) error = %v, want %v", tc.input, err, tc.err)
+				}
+				continue
+			}
+			if err != nil || n.String() != tc.expected {
+				t.Errorf("UnmarshalJSON(%s) strict %v = %q, %v, want %q", tc.input, strict, n, err, tc.expected)
+			}
+		}
+	}
+
+	SetJSONStrict(true)
+	var n Numeric
+	if err := n.UnmarshalJSON([]byte(`"1.2.3"`)); !errors.Is(err, ErrParseFormatNumeric) {
+		t.Errorf("strict UnmarshalJSON(\"1.2.3\") error = %v, want %v", err, ErrParseFormatNumeric)
+	}
+}
+
+func TestUnmarshalJSONNoAllocs(t *testing.T) {
+	for _, input := range []string{`"123456789.123456789"`, `-0.5`, `"NaN"`, `"<1"`, `"1.5e-3"`} {
+		data := []byte(input)
+		var n Numeric
+		allocs := testing.AllocsPerRun(100, func() {
+			if err := n.UnmarshalJSON(data); err != nil {
+				t.Fatalf("UnmarshalJSON(%s) failed: %v", input, err)
+			}
+		})
+		if allocs != 0 {
+			t.Errorf("UnmarshalJSON(%s) allocated %v times, want 0", input, allocs)
+		}
+	}
+}
+
+func TestNumericStringWithPrecision(t *testing.T) {
+	third := FromInt(1).Div(FromInt(3))
+	twoThirds := FromInt(-2).Div(FromInt(3))
+
+	tests := []struct {
+		n        Numeric
+		places   int
+		expected string
+	}{
+		{third, -1, "~0.333333333333333333333333333333333333"},
+		{third, 4, "0.3333"},
+		{twoThirds, -1, "~-0.666666666666666666666666666666666666"},
+		{twoThirds, 4, "-0.6667"},
+		{twoThirds, 0, "-1"},
+		{FromInt(5), 4, "5"},
+		{NaN(), 4, "NaN"},
+	}
+
+	for _, tc := range tests {
+		if got := tc.n.StringWithPrecision(tc.places); got != tc.expected {
+			t.Errorf("StringWithPrecision(%s, %d) = %q, want %q", tc.n.String(), tc.places, got, tc.expected)
+		}
+	}
+	if !third.HasUnderflow() {
+		t.Error("StringWithPrecision modified the value")
+	}
+}
+
+func TestSetDisplayPrecision(t *testing.T) {
+	t.Cleanup(func() { SetDisplayPrecision(-1) })
+	third := FromInt(1).Div(FromInt(3))
+
+	if got := DisplayPrecision(); got != -1 {
+		t.Fatalf("default DisplayPrecision() = %d, want -1", got)
+	}
+	full := fmt.Sprint(third)
+	if full != third.String() {
+		t.Errorf("full precision Sprint = %q, want %q", full, third.String())
+	}
+
+	SetDisplayPrecision(4)
+	if got := DisplayPrecision(); got != 4 {
+		t.Errorf("DisplayPrecision() = %d, want 4", got)
+	}
+	for _, format := range []string{"%v", "%s"} {
+		if got := fmt.Sprintf(format, third); got != "0.3333" {
+			t.Errorf("Sprintf(%q) = %q, want 0.3333", format, got)
+		}
+	}
+	if got := fmt.Sprintf("%q", third); got != `"0.3333"` {
+		t.Errorf("Sprintf(%%q) = %s, want \"0.3333\"", got)
+	}
+	// String and the encodings keep full precision.
+	if got := third.String(); got != full {
+		t.Errorf("String() = %q, want %q", got, full)
+	}
+	if b, _ := third.MarshalJSON(); string(b) != `"`+full+`"` {
+		t.Errorf("MarshalJSON() = %s, want full precision", b)
+	}
+
+	// huge places clamp rather than wrap.
+	SetDisplayPrecision(math.MaxInt)
+	if got := DisplayPrecision(); got != MaxDecimalPlaces {
+		t.Errorf("DisplayPrecision() = %d, want %d", got, MaxDecimalPlaces)
+	}
+	if got := fmt.Sprint(FromInt(1)); got != "1" {
+		t.Errorf("Sprint(1) = %q, want 1", got)
+	}
+
+	SetDisplayPrecision(-1)
+	if got := fmt.Sprint(third); got != full {
+		t.Errorf("Sprint after reset = %q, want %q", got, full)
+	}
+}
+
 func TestNumericFormat(t *testing.T) {
 	tests := []struct {
 		num    Numeric
@@ -1305,6 +3194,68 @@ func TestNaN(t *testing.T) {
 	}
 }
 
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    Numeric
+		expected string
+	}{
+		{"zero", Zero, "0"},
+		{"one", One(false), "1"},
+		{"ten", Ten, "10"},
+		{"hundred", Hundred, "100"},
+		{"half", Half, "0.5"},
+	}
+
+	for _, tc := range tests {
+		if got := tc.value.String(); got != tc.expected {
+			t.Errorf("%s = %q, want %q", tc.name, got, tc.expected)
+		}
+		c, ok := Constant(tc.name)
+		if !ok || !c.IsIdentical(tc.value) {
+			t.Errorf("Constant(%q) = %q, %t, want %q", tc.name, c, ok, tc.expected)
+		}
+	}
+
+	if _, ok := Constant("pi"); ok {
+		t.Error("Constant(pi) should not be found")
+	}
+
+	// arithmetic on a constant leaves it unchanged.
+	_ = Half.Add(Ten)
+	if Half.String() != "0.5" || Ten.String() != "10" {
+		t.Errorf("constants changed to %s and %s", Half, Ten)
+	}
+}
+
+func TestMaxMinValue(t *testing.T) {
+	const want = "999999999999999999.999999999999999999999999999999999999"
+
+	maxV, minV := MaxValue(), MinValue()
+	if got := maxV.String(); got != want {
+		t.Errorf("MaxValue() = %q, want %q", got, want)
+	}
+	if got := minV.String(); got != "-"+want {
+		t.Errorf("MinValue() = %q, want %q", got, "-"+want)
+	}
+	if maxV.HasOverflow() || minV.HasOverflow() {
+		t.Error("MaxValue() and MinValue() should not overflow")
+	}
+	if !minV.Neg().IsIdentical(maxV) {
+		t.Errorf("MinValue().Neg() = %q, want MaxValue()", minV.Neg())
+	}
+	unit, _ := FromString("0.000000000000000000000000000000000001")
+	if !maxV.Add(unit).HasOverflow() {
+		t.Error("MaxValue() plus the smallest unit should overflow")
+	}
+	if got := len(strings.Split(want, ".")[0]); got != MaxWholeDigits {
+		t.Errorf("MaxWholeDigits = %d, want %d", MaxWholeDigits, got)
+	}
+	if got := len(strings.Split(want, ".")[1]); got != MaxDecimalPlaces {
+		t.Errorf("MaxDecimalPlaces = %d, want %d", MaxDecimalPlaces, got)
+	}
+}
+
 func TestOneIsMinusOne(t *testing.T) {
 	// Test that One is a valid Numeric representation of 1
 	one := One(true)
@@ -1319,6 +3270,183 @@ func TestOneIsMinusOne(t *testing.T) {
 	}
 }
 
+func TestNumericDigits(t *testing.T) {
+	type digit struct {
+		digit uint8
+		place int
+	}
+	tests := []struct {
+		input       string
+		expected    []digit
+		exceptional bool
+	}{
+		{"12.34", []digit{{1, 2}, {2, 1}, {3, -1}, {4, -2}}, false},
+		{"-12.34", []digit{{1, 2}, {2, 1}, {3, -1}, {4, -2}}, false},
+		{"100", []digit{{1, 3}, {0, 2}, {0, 1}}, false},
+		{"10.05", []digit{{1, 2}, {0, 1}, {0, -1}, {5, -2}}, false},
+		{"0.007", []digit{{7, -3}}, false},
+		{"~0.5", []digit{{5, -1}}, false},
+		{"0", nil, false},
+		{"NaN", nil, true},
+		{"1e19", nil, true},
+		{"-1e19", nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			var got []digit
+			exceptional := n.Digits(func(d uint8, place int) bool {
+				got = append(got, digit{d, place})
+				return true
+			})
+			if exceptional != tc.exceptional || !slices.Equal(got, tc.expected) {
+				t.Errorf("FromString(%q).Digits() = %v, %v, want %v, %v", tc.input, got, exceptional, tc.expected, tc.exceptional)
+			}
+		})
+	}
+
+	n, _ := FromString("12.34")
+	var count int
+	n.Digits(func(uint8, int) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Digits called fn %d times after it returned false, want 2", count)
+	}
+}
+
+func TestNumericWords(t *testing.T) {
+	tests := []struct {
+		input string
+		words [6]uint32
+	}{
+		{"0", [6]uint32{}},
+		{"12.5", [6]uint32{0, 12, 500000000, 0, 0, 0}},
+		{"-12.5", [6]uint32{0, 12, 500000000, 0, 0, 0}},
+		{"1234567890.000000001", [6]uint32{1, 234567890, 1, 0, 0, 0}},
+		{"0.000000000000000000000000000000000001", [6]uint32{0, 0, 0, 0, 0, 1}},
+		{"~0.5", [6]uint32{0, 0, 500000000, 0, 0, 0}},
+		{"1e19", [6]uint32{999999999, 999999999, 999999999, 999999999, 999999999, 999999999}},
+		{"-1e19", [6]uint32{999999999, 999999999, 999999999, 999999999, 999999999, 999999999}},
+		{"NaN", [6]uint32{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			words := n.Words()
+			if words != tc.words {
+				t.Errorf("FromString(%q).Words() = %v, want %v", tc.input, words, tc.words)
+			}
+			back := FromWords(words, n.z.isNeg(), n.IsNaN(), n.HasOverflow(), n.HasUnderflow())
+			if !back.IsIdentical(n) {
+				t.Errorf("FromWords(%v) = %q, want %q", words, back, n)
+			}
+		})
+	}
+
+	if n := FromWords([6]uint32{0, 1e9}, false, false, false, false); !n.IsNaN() {
+		t.Errorf("FromWords with a word of 1e9 = %q, want NaN", n)
+	}
+}
+
+func TestFromScaledInt(t *testing.T) {
+	over := "<999999999999999999.999999999999999999999999999999999999"
+	tests := []struct {
+		mantissa int64
+		scale    int
+		expected string
+	}{
+		{12345, 2, "123.45"},
+		{-12345, 2, "-123.45"},
+		{12345, 0, "12345"},
+		{12345, 5, "0.12345"},
+		{12345, 7, "0.0012345"},
+		{12345, -3, "12345000"},
+		{0, 2, "0"},
+		{0, -40, "0"},
+		{100, 2, "1"},
+		{1, 36, "0.000000000000000000000000000000000001"},
+		{1, 37, "~0"},
+		{12345, 38, "~0.000000000000000000000000000000000123"},
+		{math.MaxInt64, 2, "92233720368547758.07"},
+		{math.MinInt64, 4, "-922337203685477.5808"},
+		{math.MaxInt64, 0, over},
+		{math.MinInt64, 0, "-" + over},
+		{1, 18, "0.000000000000000001"},
+		{1, -17, "100000000000000000"},
+		{1, -18, over},
+		{5, math.MaxInt, "~0"},
+		{-5, math.MaxInt, "~-0"},
+		{5, math.MinInt, over},
+		{-5, math.MinInt, "-" + over},
+		{0, math.MinInt, "0"},
+	}
+
+	for _, tc := range tests {
+		n := FromScaledInt(tc.mantissa, tc.scale)
+		if n.String() != tc.expected {
+			t.Errorf("FromScaledInt(%d, %d) = %q, want %q", tc.mantissa, tc.scale, n, tc.expected)
+		}
+		// the inverse shift recovers the mantissa when nothing was lost.
+		if n.IsUnderOverNaN() || ValidateIntRange(tc.mantissa) != nil {
+			continue
+		}
+		if m, err := n.ShiftLeft(tc.scale).Int64(); err != nil || m != tc.mantissa {
+			t.Errorf("FromScaledInt(%d, %d).ShiftLeft(%d).Int64() = %d, %v, want %d", tc.mantissa, tc.scale, tc.scale, m, err, tc.mantissa)
+		}
+	}
+}
+
+func TestNumericScaledInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		scale    int
+		mode     RoundMode
+		expected int64
+		err      error
+	}{
+		{"123.456", 2, RoundHalfUp, 12346, nil},
+		{"123.456", 2, RoundTowards, 12345, nil},
+		{"123.455", 2, RoundHalfEven, 12346, nil},
+		{"123.445", 2, RoundHalfEven, 12344, nil},
+		{"-123.456", 2, RoundHalfUp, -12346, nil},
+		{"-123.451", 2, RoundFloor, -12346, nil},
+		{"-123.459", 2, RoundCeil, -12345, nil},
+		{"123.456", 0, RoundHalfUp, 123, nil},
+		{"123.456", 5, RoundHalfUp, 12345600, nil},
+		{"123.456", -1, RoundHalfUp, 12, nil},
+		{"125", -1, RoundHalfEven, 12, nil},
+		{"0.000000000000000000000000000000000001", 36, RoundHalfUp, 1, nil},
+		{"0.000000000000000000000000000000000001", 38, RoundHalfUp, 100, nil},
+		{"~0.5", 0, RoundHalfUp, 1, nil},
+		{"0", 10, RoundHalfUp, 0, nil},
+		{"10.5", 1, RoundHalfUp, 105, nil},
+		{"92233720368547758.07", 2, RoundHalfUp, math.MaxInt64, nil},
+		{"-92233720368547758.08", 2, RoundHalfUp, math.MinInt64, nil},
+		{"92233720368547758.08", 2, RoundHalfUp, 0, ErrIntegerOutOfRange},
+		{"92233720368547758.075", 2, RoundHalfUp, 0, ErrIntegerOutOfRange},
+		{"1", 19, RoundHalfUp, 0, ErrIntegerOutOfRange},
+		{"1e19", 0, RoundHalfUp, 0, ErrIntegerOutOfRange},
+		{"NaN", 2, RoundHalfUp, 0, ErrNotANumber},
+	}
+
+	for _, tc := range tests {
+		n, _ := FromString(tc.input)
+		v, err := n.ScaledInt(tc.scale, tc.mode)
+		if !errors.Is(err, tc.err) || v != tc.expected {
+			t.Errorf("FromString(%q).ScaledInt(%d, %s) = %d, %v, want %d, %v", tc.input, tc.scale, tc.mode, v, err, tc.expected, tc.err)
+		}
+		if tc.err == nil && tc.scale >= 0 {
+			if back := FromScaledInt(v, tc.scale); !back.IsEqual(n.Round(tc.scale, tc.mode)) {
+				t.Errorf("FromScaledInt(%d, %d) = %q, want %q", v, tc.scale, back, n.Round(tc.scale, tc.mode))
+			}
+		}
+	}
+}
+
 func TestValidateIntRange(t *testing.T) {
 	type testCase struct {
 		value    int64
@@ -1344,6 +3472,84 @@ func TestValidateIntRange(t *testing.T) {
 	}
 }
 
+func TestValidateRangeErrors(t *testing.T) {
+	if err := ValidateIntRange(maxValueI + 1); !errors.Is(err, ErrIntegerOutOfRange) {
+		t.Errorf("ValidateIntRange(maxValueI+1) = %v, want %v", err, ErrIntegerOutOfRange)
+	}
+	if err := ValidateIntRange(-maxValueI - 1); !errors.Is(err, ErrIntegerOutOfRange) {
+		t.Errorf("ValidateIntRange(-maxValueI-1) = %v, want %v", err, ErrIntegerOutOfRange)
+	}
+	if err := ValidateFloatRange(maxValueF64 + 100); !errors.Is(err, ErrFloatOutOfRange) {
+		t.Errorf("ValidateFloatRange(maxValueF64+100) = %v, want %v", err, ErrFloatOutOfRange)
+	}
+	if err := ValidateFloatRange(-maxValueF64 - 100); !errors.Is(err, ErrFloatOutOfRange) {
+		t.Errorf("ValidateFloatRange(-maxValueF64-100) = %v, want %v", err, ErrFloatOutOfRange)
+	}
+	if errors.Is(ValidateIntRange(maxValueI+1), ErrFloatOutOfRange) || errors.Is(ValidateFloatRange(maxValueF64+100), ErrIntegerOutOfRange) {
+		t.Error("integer and float range errors should be distinct")
+	}
+}
+
+func TestFromInt64Checked(t *testing.T) {
+	tests := []struct {
+		value    int64
+		expected string
+		ok       bool
+	}{
+		{0, "0", true},
+		{-42, "-42", true},
+		{maxValueI, "999999999999999999", true},
+		{-maxValueI, "-999999999999999999", true},
+		{maxValueI + 1, "", false},
+		{-maxValueI - 1, "", false},
+		{math.MaxInt64, "", false},
+		{math.MinInt64, "", false},
+	}
+
+	for _, tc := range tests {
+		n, err := FromInt64Checked(tc.value)
+		if !tc.ok {
+			if !errors.Is(err, ErrIntegerOutOfRange) {
+				t.Errorf("FromInt64Checked(%d) error = %v, want %v", tc.value, err, ErrIntegerOutOfRange)
+			}
+			continue
+		}
+		if err != nil || n.String() != tc.expected {
+			t.Errorf("FromInt64Checked(%d) = %q, %v, want %q", tc.value, n, err, tc.expected)
+		}
+	}
+}
+
+func TestFromUint64Checked(t *testing.T) {
+	tests := []struct {
+		value    uint64
+		expected string
+		ok       bool
+	}{
+		{0, "0", true},
+		{42, "42", true},
+		{maxValue - 1, "999999999999999998", true},
+		{maxValue, "999999999999999999", true},
+		{maxValue + 1, "", false},
+		{math.MaxInt64, "", false},
+		{math.MaxInt64 + 1, "", false},
+		{math.MaxUint64, "", false},
+	}
+
+	for _, tc := range tests {
+		n, err := FromUint64Checked(tc.value)
+		if !tc.ok {
+			if !errors.Is(err, ErrIntegerOutOfRange) {
+				t.Errorf("FromUint64Checked(%d) error = %v, want %v", tc.value, err, ErrIntegerOutOfRange)
+			}
+			continue
+		}
+		if err != nil || n.String() != tc.expected {
+			t.Errorf("FromUint64Checked(%d) = %q, %v, want %q", tc.value, n, err, tc.expected)
+		}
+	}
+}
+
 func TestValidateFloatRange(t *testing.T) {
 	type testCase struct {
 		value    float64
@@ -1371,6 +3577,126 @@ func TestValidateFloatRange(t *testing.T) {
 	}
 }
 
+func TestCanRepresentFloat64(t *testing.T) {
+	tests := []struct {
+		input    float64
+		expected bool
+	}{
+		{0, true},
+		{math.Copysign(0, -1), true},
+		{0.1, true},
+		{-123456789.12345678, true},
+		{1.0000000000000002, true},
+		{1e-18, true},
+		{-1.5e-17, true},
+		{maxValueF64, true},
+		{-maxValueF64, true},
+		{1e18, false},
+		{math.Nextafter(maxValueF64, math.Inf(1)), false},
+		{1e19, false},
+		{1e-19, false},
+		{1e-36, false},
+		{1e-37, false},
+		{math.SmallestNonzeroFloat64, false},
+		{-math.SmallestNonzeroFloat64, false},
+		{0x1p-1022, false},
+		{math.NaN(), false},
+		{math.Inf(1), false},
+		{math.Inf(-1), false},
+	}
+
+	for _, tc := range tests {
+		if got := CanRepresentFloat64(tc.input); got != tc.expected {
+			t.Errorf("CanRepresentFloat64(%g) = %v, want %v", tc.input, got, tc.expected)
+		}
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	inputs := []string{"1.5", "NaN", "<1", "-<1", "~0.333", "-2", "1e-40", "0"}
+	want := []error{nil, ErrNotANumber, ErrOverflow, ErrOverflow, ErrUnderflow, nil, ErrUnderflow, nil}
+
+	nums := make([]Numeric, len(inputs))
+	for i, s := range inputs {
+		nums[i], _ = FromString(s)
+	}
+
+	errs := ValidateAll(nums)
+	if len(errs) != len(nums) {
+		t.Fatalf("ValidateAll returned %d errors, want %d", len(errs), len(nums))
+	}
+	for i, err := range errs {
+		if want[i] == nil {
+			if err != nil {
+				t.Errorf("ValidateAll[%d] (%s) = %v, want nil", i, inputs[i], err)
+			}
+			continue
+		}
+		if !errors.Is(err, want[i]) {
+			t.Errorf("ValidateAll[%d] (%s) = %v, want %v", i, inputs[i], err, want[i])
+		}
+		if !strings.Contains(err.Error(), fmt.Sprintf("index %d", i)) {
+			t.Errorf("ValidateAll[%d] = %q, want it to name the index", i, err)
+		}
+	}
+
+	if errs := ValidateAll(nil); len(errs) != 0 {
+		t.Errorf("ValidateAll(nil) = %v, want empty", errs)
+	}
+}
+
+func TestNumericState(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected State
+		name     string
+	}{
+		{"0", StateNormal, "normal"},
+		{"-123.456", StateNormal, "normal"},
+		{"NaN", StateNaN, "NaN"},
+		{"<1", StateOverflow, "overflow"},
+		{"-<1", StateOverflow, "overflow"},
+		{"~0.333", StateUnderflow, "underflow"},
+		{"1e-40", StateUnderflow, "underflow"},
+		{"~-<1", StateOverflow, "overflow"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			got := n.State()
+			if got != tc.expected {
+				t.Errorf("State(%q) = %v, want %v", tc.input, got, tc.expected)
+			}
+			if got.String() != tc.name {
+				t.Errorf("State(%q).String() = %q, want %q", tc.input, got.String(), tc.name)
+			}
+			if n.IsUnderOverNaN() != (got != StateNormal) {
+				t.Errorf("IsUnderOverNaN(%q) = %t, inconsistent with State %v", tc.input, n.IsUnderOverNaN(), got)
+			}
+		})
+	}
+
+	if both, _ := FromString("~-<1"); !both.HasUnderflow() || !both.HasOverflow() {
+		t.Errorf("FromString(~-<1) = %q, want both underflow and overflow", both)
+	}
+
+	// combined flags built directly, NaN taking precedence.
+	var z f24
+	z.setNaN(true)
+	z.setOverflow(true)
+	z.setUnderflow(true)
+	if got := (Numeric{z: z}).State(); got != StateNaN {
+		t.Errorf("State(NaN with overflow and underflow) = %v, want %v", got, StateNaN)
+	}
+	if State(99).String() != "" {
+		t.Errorf("State(99).String() = %q, want empty", State(99))
+	}
+}
+
 func TestIsUnderOverNaN(t *testing.T) {
 	type testCase struct {
 		value       string
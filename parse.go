@@ -0,0 +1,310 @@
+package numeric
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+const (
+	// StylePlain indicates the input was written in plain decimal notation, e.g. 1234.5.
+	StylePlain Style = iota
+
+	// StyleScientific indicates the input was written with an exponent, e.g. 1.2345e3.
+	StyleScientific
+)
+
+// Style reports the notation a parsed string was written in.
+type Style int
+
+// styleString maps Style values to human-readable strings.
+var styleString = map[Style]string{
+	StylePlain:      "plain",
+	StyleScientific: "scientific",
+}
+
+// String returns the string name for the Style.
+func (st Style) String() string {
+	v, ok := styleString[st]
+	if ok {
+		return v
+	}
+	return ""
+}
+
+// FromIntString parses a string of decimal digits, with an optional sign,
+// into a Numeric. It is faster than FromString and stricter: a decimal point
+// or exponent returns ErrNotAnInteger, so fractional values in integer fields
+// are caught. Values beyond 999999999999999999 return an overflow value.
+// As with FromString, "-0" gives a negative zero, which String prints as 0
+// and Float64 returns as -0.
+func FromIntString(s string) (Numeric, error) {
+	t := strings.TrimSpace(s)
+	var isNeg bool
+	if len(t) > 0 && (t[0] == '-' || t[0] == '+') {
+		isNeg, t = t[0] == '-', t[1:]
+	}
+	if len(t) == 0 {
+		return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, ErrNoDigitsInInput, s)
+	}
+
+	var u uint64
+	var digits int
+	for _, ch := range t {
+		switch {
+		case ch >= '0' && ch <= '9':
+			if digits > 0 || ch != '0' {
+				digits++
+			}
+			if digits <= maxWholeDigits {
+				u = u*10 + uint64(ch-'0')
+			}
+		case ch == '.' || ch == 'e' || ch == 'E':
+			return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, ErrNotAnInteger, s)
+		default:
+			return Numeric{}, fmt.Errorf("%w: %w: %q for %s", ErrParseFormatNumeric, ErrInvalidCharacter, ch, s)
+		}
+	}
+	if digits > maxWholeDigits {
+		return Numeric{z: overflow(isNeg)}, nil
+	}
+
+	var z f24
+	z[0].setVal(uint32(u / radix))
+	z[1].setVal(uint32(u % radix))
+	z.setNeg(isNeg)
+	return Numeric{z: z}, nil
+}
+
+// FromStringWithStyle parses a string like FromString and also returns the
+// Style it was written in, so a value can be re-emitted in the same notation.
+// NaN reports StylePlain.
+func FromStringWithStyle(s string) (Numeric, Style, error) {
+	d, err := parseString(s)
+	if err != nil {
+		return Numeric{}, StylePlain, err
+	}
+	style := StylePlain
+	if d.isExp {
+		style = StyleScientific
+	}
+	return Numeric{z: d.F24()}, style, nil
+}
+
+// FromStringLenient parses a string into a Numeric like FromString, but also
+// accepts spacing commonly found in exported data:
+//
+//   - spaces between the sign (or ~ and < markers) and the digits, e.g. "- 1.23".
+//   - spaces grouping the whole digits in threes, e.g. "1 234 567.89".
+//
+// Any other spacing, such as within the decimal places or uneven groups
+// ("1 2.3 4", "12 34"), is rejected with ErrInvalidGrouping.
+func FromStringLenient(s string) (Numeric, error) {
+	var buf [2 * precision]byte
+	b, err := appendLenient(buf[:0], strings.TrimSpace(s))
+	if err != nil {
+		return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, s)
+	}
+	return FromString(unsafe.String(unsafe.SliceData(b), len(b)))
+}
+
+// FromStringStrict parses a string like FromString, but returns
+// ErrValueOutOfRange instead of an overflow or underflow value, including
+// inputs with an explicit '<' or '~' marker. Format errors are returned as usual.
+func FromStringStrict(s string) (Numeric, error) {
+	return FromStringValidated(s)
+}
+
+// FromStringValidated parses a string like FromString, but returns ErrOverflow
+// or ErrUnderflow when the parsed value carries that flag, including inputs
+// with an explicit '<' or '~' marker. Both errors also match ErrValueOutOfRange.
+// Format errors are returned as usual.
+func FromStringValidated(s string) (Numeric, error) {
+	d, err := parseString(s)
+	if err != nil {
+		return Numeric{}, err
+	}
+	z := d.F24()
+	isNaN := z.isNaN() && !d.isOverflow && !d.isUnderflow
+	switch {
+	case isNaN:
+	case d.isOverflow || z.isOverflow():
+		return Numeric{}, fmt.Errorf("%w: %w: %s", ErrValueOutOfRange, ErrOverflow, s)
+	case d.isUnderflow || z.isUnderflow():
+		return Numeric{}, fmt.Errorf("%w: %w: %s", ErrValueOutOfRange, ErrUnderflow, s)
+	}
+	return Numeric{z: z}, nil
+}
+
+// FromStringExtended parses a string like FromString, but also maps the
+// infinity literals "Inf" and "Infinity" (case insensitive, optionally signed)
+// to the positive or negative overflow value.
+func FromStringExtended(s string) (Numeric, error) {
+	t := strings.TrimSpace(s)
+	body := strings.TrimLeft(t, "+-")
+	if len(t)-len(body) <= 1 && (strings.EqualFold(body, "inf") || strings.EqualFold(body, "infinity")) {
+		return Numeric{z: overflow(t[0] == '-')}, nil
+	}
+	return FromString(s)
+}
+
+// FromPercent parses a percentage such as "12.5%" into its fractional value 0.125.
+// The trailing '%' is optional; the remainder is parsed with FromString and
+// divided by 100, marking underflow if decimal places are lost.
+func FromPercent(s string) (Numeric, error) {
+	if strings.Count(s, "%") > 1 {
+		return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, ErrMultiplePercentSigns, s)
+	}
+	n, err := FromString(strings.TrimSuffix(strings.TrimSpace(s), "%"))
+	if err != nil {
+		return Numeric{}, err
+	}
+	var z f24
+	arith.div(&z, &n.z, &hundred)
+	return Numeric{z: z}, nil
+}
+
+// SliceError reports the first string ParseSlice failed to parse.
+type SliceError struct {
+	Index int   // Index of the failing string.
+	Err   error // Err is the parse error.
+}
+
+// Error implements the error interface.
+func (e *SliceError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the parse error, so errors.Is matches ErrParseFormatNumeric.
+func (e *SliceError) Unwrap() error {
+	return e.Err
+}
+
+// ParseSlice parses each string in ss like FromString, returning a slice the
+// same length as ss. Parsing stops at the first invalid string, returning a
+// nil slice and a *SliceError holding its index.
+func ParseSlice(ss []string) ([]Numeric, error) {
+	out := make([]Numeric, len(ss))
+	var d digits
+	for i, s := range ss {
+		d = digits{}
+		if err := d.parse(s); err != nil {
+			return nil, &SliceError{Index: i, Err: err}
+		}
+		out[i] = Numeric{z: d.F24()}
+	}
+	return out, nil
+}
+
+// ParseSliceLenient parses each string in ss like ParseSlice, but
+// substitutes NaN for strings that cannot be parsed rather than failing.
+func ParseSliceLenient(ss []string) []Numeric {
+	out := make([]Numeric, len(ss))
+	var d digits
+	for i, s := range ss {
+		d = digits{}
+		if err := d.parse(s); err != nil {
+			out[i] = NaN()
+			continue
+		}
+		out[i] = Numeric{z: d.F24()}
+	}
+	return out
+}
+
+// appendLenient appends s to b with the lenient spacing removed.
+func appendLenient(b []byte, s string) ([]byte, error) {
+	i := 0
+
+	// prefix markers may be followed by spaces.
+prefix:
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == '~' || r == '<' || r == '-' || r == '+':
+			b = append(b, byte(r))
+		case unicode.IsSpace(r):
+		default:
+			break prefix
+		}
+		i += size
+	}
+
+	// whole digits may be grouped in threes by single spaces.
+	var digits int
+	var grouped bool
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if unicode.IsSpace(r) {
+			if digits == 0 || digits > 3 || (grouped && digits != 3) {
+				return nil, ErrInvalidGrouping
+			}
+			grouped = true
+			digits = 0
+			i += size
+			continue
+		}
+		if r < '0' || r > '9' {
+			break
+		}
+		b = append(b, byte(r))
+		digits++
+		i += size
+	}
+	if grouped && digits != 3 {
+		return nil, ErrInvalidGrouping
+	}
+
+	// the remainder is passed through unchanged, but may not contain spaces.
+	rest := s[i:]
+	if strings.IndexFunc(rest, unicode.IsSpace) >= 0 {
+		return nil, ErrInvalidGrouping
+	}
+	return append(b, rest...), nil
+}
+
+// FromToken parses a number token as produced by text/scanner into a Numeric.
+//
+// Accepted token forms are:
+//
+//   - decimal integers and floats, including exponents and '_' separators, e.g. "1_000", "1.5e3".
+//   - prefixed integers in hex, octal and binary, e.g. "0x1f", "0o17", "017", "0b101".
+//   - hexadecimal floats, e.g. "0x1p-2", which are converted through float64.
+//
+// An optional leading sign is also accepted. Integers beyond the Numeric range overflow.
+func FromToken(tok string) (Numeric, error) {
+	body := strings.TrimLeft(tok, "+-")
+	isNeg := strings.Count(tok[:len(tok)-len(body)], "-") == 1
+	isHex := len(body) > 1 && body[0] == '0' && (body[1] == 'x' || body[1] == 'X')
+
+	switch {
+	case isHex && strings.ContainsAny(body, ".pP"):
+		f, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, tok)
+		}
+		return FromFloat64(f), nil
+	case isHex || !strings.ContainsAny(body, ".eE"):
+		i, err := strconv.ParseInt(tok, 0, 64)
+		switch {
+		case errors.Is(err, strconv.ErrRange):
+			return Numeric{z: overflow(isNeg)}, nil
+		case err != nil:
+			return Numeric{}, fmt.Errorf("%w: %w for %s", ErrParseFormatNumeric, err, tok)
+		}
+		return FromInt(i), nil
+	default:
+		var buf [2 * precision]byte
+		b := buf[:0]
+		for i := range len(tok) {
+			if tok[i] != '_' {
+				b = append(b, tok[i])
+			}
+		}
+		return FromString(unsafe.String(unsafe.SliceData(b), len(b)))
+	}
+}
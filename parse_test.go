@@ -0,0 +1,470 @@
+package numeric
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"text/scanner"
+)
+
+func TestFromStringLenient(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+		err      error
+	}
+
+	tests := []testCase{
+		{"1.23", "1.23", nil},
+		{"  1.23  ", "1.23", nil},
+		{"- 1.23", "-1.23", nil},
+		{"+ 1.23", "1.23", nil},
+		{"~ - 1.23", "~-1.23", nil},
+		{"1 234", "1234", nil},
+		{"1 234 567.89", "1234567.89", nil},
+		{"-1 234 567.89", "-1234567.89", nil},
+		{"- 12 345", "-12345", nil},
+		{"123 456e3", "123456000", nil},
+		{"1 234", "1234", nil},
+		{"NaN", "NaN", nil},
+
+		{"1 2.3 4", "", ErrInvalidGrouping},
+		{"12 34", "", ErrInvalidGrouping},
+		{"1234 567", "", ErrInvalidGrouping},
+		{"1 2345", "", ErrInvalidGrouping},
+		{"1  234", "", ErrInvalidGrouping},
+		{"1.234 567", "", ErrInvalidGrouping},
+		{"1e 3", "", ErrInvalidGrouping},
+		{"1 23a", "", ErrInvalidGrouping},
+		{"- abc", "", ErrInvalidCharacter},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromStringLenient(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) || !errors.Is(err, ErrParseFormatNumeric) {
+					t.Fatalf("FromStringLenient(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringLenient(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromStringLenient(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFromToken(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+		wantErr  bool
+	}
+
+	tests := []testCase{
+		{"0", "0", false},
+		{"42", "42", false},
+		{"-42", "-42", false},
+		{"1_000_000", "1000000", false},
+		{"1.5", "1.5", false},
+		{"1.5e3", "1500", false},
+		{"1.5E-3", "0.0015", false},
+		{".25", "0.25", false},
+		{"1e2", "100", false},
+		{"1_000.000_1", "1000.0001", false},
+		{"0x1f", "31", false},
+		{"0X1F", "31", false},
+		{"-0x10", "-16", false},
+		{"0o17", "15", false},
+		{"017", "15", false},
+		{"0b101", "5", false},
+		{"0x1p-2", "0.25", false},
+		{"0x1.8p1", "3", false},
+		{"99999999999999999999", "<999999999999999999.999999999999999999999999999999999999", false},
+		{"-0xffffffffffffffffff", "-<999999999999999999.999999999999999999999999999999999999", false},
+		{"0x", "", true},
+		{"09", "", true},
+		{"0b102", "", true},
+		{"1.5x", "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromToken(tc.input)
+			if tc.wantErr {
+				if !errors.Is(err, ErrParseFormatNumeric) {
+					t.Fatalf("FromToken(%q) error = %v, want %v", tc.input, err, ErrParseFormatNumeric)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromToken(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromToken(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFromTokenScanner(t *testing.T) {
+	var s scanner.Scanner
+	s.Init(strings.NewReader("1.5e3 + 0x1f * 2_000"))
+	var got []string
+	for tok := s.Scan(); tok != scanner.EOF; tok = s.Scan() {
+		if tok != scanner.Int && tok != scanner.Float {
+			continue
+		}
+		n, err := FromToken(s.TokenText())
+		if err != nil {
+			t.Fatalf("FromToken(%q) failed: %v", s.TokenText(), err)
+		}
+		got = append(got, n.String())
+	}
+
+	if want := []string{"1500", "31", "2000"}; !slices.Equal(got, want) {
+		t.Errorf("scanned numbers = %v, want %v", got, want)
+	}
+}
+
+func TestFromStringStrict(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"0", "0", nil},
+		{"-123.456", "-123.456", nil},
+		{"999999999999999999.999999999999999999999999999999999999", "999999999999999999.999999999999999999999999999999999999", nil},
+		{"0.000000000000000000000000000000000001", "0.000000000000000000000000000000000001", nil},
+		{"1e17", "100000000000000000", nil},
+		{"NaN", "NaN", nil},
+		{"1e30", "", ErrValueOutOfRange},
+		{"-1e18", "", ErrValueOutOfRange},
+		{"1000000000000000000", "", ErrValueOutOfRange},
+		{"<1", "", ErrValueOutOfRange},
+		{"1e-37", "", ErrValueOutOfRange},
+		{"-1e-100", "", ErrValueOutOfRange},
+		{"0.0000000000000000000000000000000000001", "", ErrValueOutOfRange},
+		{"~1.5", "", ErrValueOutOfRange},
+		{"1.2.3", "", ErrParseFormatNumeric},
+		{"abc", "", ErrParseFormatNumeric},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromStringStrict(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("FromStringStrict(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringStrict(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromStringStrict(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFromStringExtended(t *testing.T) {
+	const over = "<999999999999999999.999999999999999999999999999999999999"
+
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"Inf", over, nil},
+		{"+Inf", over, nil},
+		{"Infinity", over, nil},
+		{" +infinity ", over, nil},
+		{"INF", over, nil},
+		{"-Inf", "-" + over, nil},
+		{"-Infinity", "-" + over, nil},
+		{"1.25", "1.25", nil},
+		{"-<1", "-" + over, nil},
+		{"NaN", "NaN", nil},
+		{"--Inf", "", ErrParseFormatNumeric},
+		{"+-Inf", "", ErrParseFormatNumeric},
+		{"Infinite", "", ErrParseFormatNumeric},
+		{"1Inf", "", ErrParseFormatNumeric},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromStringExtended(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("FromStringExtended(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringExtended(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromStringExtended(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFromPercent(t *testing.T) {
+	type testCase struct {
+		input    string
+		expected string
+		err      error
+	}
+
+	tests := []testCase{
+		{"12.5%", "0.125", nil},
+		{"-0.01%", "-0.0001", nil},
+		{"100%", "1", nil},
+		{"12.5", "0.125", nil},
+		{" 7% ", "0.07", nil},
+		{"~50%", "~0.5", nil},
+		{"0.000000000000000000000000000000000001%", "~0", nil},
+		{"12.5%%", "", ErrMultiplePercentSigns},
+		{"%12%", "", ErrMultiplePercentSigns},
+		{"%12", "", ErrInvalidCharacter},
+		{"abc%", "", ErrParseFormatNumeric},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromPercent(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("FromPercent(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromPercent(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromPercent(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPercentRoundTrip(t *testing.T) {
+	for _, s := range []string{"12.5%", "-0.01%", "0%", "250%"} {
+		n, err := FromPercent(s)
+		if err != nil {
+			t.Fatalf("FromPercent(%q) failed: %v", s, err)
+		}
+		if got := n.Percent(); got != s {
+			t.Errorf("FromPercent(%q).Percent() = %q", s, got)
+		}
+	}
+
+	if got := NaN().Percent(); got != "NaN" {
+		t.Errorf("NaN().Percent() = %q, want NaN", got)
+	}
+}
+
+func TestFromStringWithStyle(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		style    Style
+		err      error
+	}{
+		{"1234.5", "1234.5", StylePlain, nil},
+		{"-0.001", "-0.001", StylePlain, nil},
+		{"1.2345e3", "1234.5", StyleScientific, nil},
+		{"1.2345E+3", "1234.5", StyleScientific, nil},
+		{"-5e-2", "-0.05", StyleScientific, nil},
+		{"~1e-40", "~0", StyleScientific, nil},
+		{"NaN", "NaN", StylePlain, nil},
+		{"1e", "", StylePlain, ErrNoExponentValue},
+		{"abc", "", StylePlain, ErrInvalidCharacter},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, style, err := FromStringWithStyle(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) || !errors.Is(err, ErrParseFormatNumeric) {
+					t.Fatalf("FromStringWithStyle(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringWithStyle(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromStringWithStyle(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+			if style != tc.style {
+				t.Errorf("FromStringWithStyle(%q) style = %v, want %v", tc.input, style, tc.style)
+			}
+		})
+	}
+}
+
+func TestStyleString(t *testing.T) {
+	if StylePlain.String() != "plain" || StyleScientific.String() != "scientific" || Style(99).String() != "" {
+		t.Errorf("unexpected Style names %q %q %q", StylePlain, StyleScientific, Style(99))
+	}
+}
+
+func TestFromStringValidated(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"-123.456", "-123.456", nil},
+		{"999999999999999999.999999999999999999999999999999999999", "999999999999999999.999999999999999999999999999999999999", nil},
+		{"1e-36", "0.000000000000000000000000000000000001", nil},
+		{"NaN", "NaN", nil},
+		{"1e100", "", ErrOverflow},
+		{"-1000000000000000000", "", ErrOverflow},
+		{"<1", "", ErrOverflow},
+		{"1e-37", "", ErrUnderflow},
+		{"0.0000000000000000000000000000000000015", "", ErrUnderflow},
+		{"~1.5", "", ErrUnderflow},
+		{"abc", "", ErrParseFormatNumeric},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromStringValidated(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("FromStringValidated(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				if tc.err != ErrParseFormatNumeric && !errors.Is(err, ErrValueOutOfRange) {
+					t.Errorf("FromStringValidated(%q) error = %v, want it to match ErrValueOutOfRange", tc.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromStringValidated(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromStringValidated(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseSlice(t *testing.T) {
+	got, err := ParseSlice([]string{"1.5", "-2", "NaN", "<1", "1e-40"})
+	if err != nil {
+		t.Fatalf("ParseSlice failed: %v", err)
+	}
+	want := []string{"1.5", "-2", "NaN", "<999999999999999999.999999999999999999999999999999999999", "~0"}
+	if len(got) != len(want) {
+		t.Fatalf("ParseSlice returned %d values, want %d", len(got), len(want))
+	}
+	for i, n := range got {
+		if n.String() != want[i] {
+			t.Errorf("ParseSlice()[%d] = %q, want %q", i, n, want[i])
+		}
+	}
+
+	got, err = ParseSlice(nil)
+	if err != nil || len(got) != 0 {
+		t.Errorf("ParseSlice(nil) = %v, %v, want empty", got, err)
+	}
+}
+
+func TestParseSliceFirstInvalid(t *testing.T) {
+	got, err := ParseSlice([]string{"1", "2", "abc", "1.2.3"})
+	if got != nil {
+		t.Errorf("ParseSlice returned %v, want nil", got)
+	}
+	var sliceErr *SliceError
+	if !errors.As(err, &sliceErr) {
+		t.Fatalf("ParseSlice error = %v, want *SliceError", err)
+	}
+	if sliceErr.Index != 2 {
+		t.Errorf("SliceError.Index = %d, want 2", sliceErr.Index)
+	}
+	if !errors.Is(err, ErrInvalidCharacter) || !errors.Is(err, ErrParseFormatNumeric) {
+		t.Errorf("ParseSlice error = %v, want ErrInvalidCharacter", err)
+	}
+	if !strings.HasPrefix(err.Error(), "index 2: ") {
+		t.Errorf("ParseSlice error = %q, want index prefix", err)
+	}
+}
+
+func TestParseSliceLenient(t *testing.T) {
+	got := ParseSliceLenient([]string{"1", "abc", "", "-0.25", "1.2.3"})
+	want := []string{"1", "NaN", "NaN", "-0.25", "NaN"}
+	if len(got) != len(want) {
+		t.Fatalf("ParseSliceLenient returned %d values, want %d", len(got), len(want))
+	}
+	for i, n := range got {
+		if n.String() != want[i] {
+			t.Errorf("ParseSliceLenient()[%d] = %q, want %q", i, n, want[i])
+		}
+	}
+}
+
+func TestFromIntString(t *testing.T) {
+	const over = "<999999999999999999.999999999999999999999999999999999999"
+
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"0", "0", nil},
+		{"123", "123", nil},
+		{"-123", "-123", nil},
+		{"+42", "42", nil},
+		{" 7 ", "7", nil},
+		{"-0", "0", nil},
+		{"000123", "123", nil},
+		{"999999999999999999", "999999999999999999", nil},
+		{"-999999999999999999", "-999999999999999999", nil},
+		{"0000000000000000000001", "1", nil},
+		{"1000000000000000000", over, nil},
+		{"-12345678901234567890", "-" + over, nil},
+		{"12.3", "", ErrNotAnInteger},
+		{"12.", "", ErrNotAnInteger},
+		{"1e3", "", ErrNotAnInteger},
+		{"1E3", "", ErrNotAnInteger},
+		{"", "", ErrNoDigitsInInput},
+		{"-", "", ErrNoDigitsInInput},
+		{"--1", "", ErrInvalidCharacter},
+		{"12a", "", ErrInvalidCharacter},
+		{"NaN", "", ErrInvalidCharacter},
+		{"1 000", "", ErrInvalidCharacter},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromIntString(tc.input)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) || !errors.Is(err, ErrParseFormatNumeric) {
+					t.Fatalf("FromIntString(%q) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FromIntString(%q) failed: %v", tc.input, err)
+			}
+			if got := n.String(); got != tc.expected {
+				t.Errorf("FromIntString(%q) = %q, want %q", tc.input, got, tc.expected)
+			}
+			if want, _ := FromString(tc.input); !n.IsIdentical(want) {
+				t.Errorf("FromIntString(%q) = %q, differs from FromString %q", tc.input, n, want)
+			}
+		})
+	}
+}
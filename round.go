@@ -0,0 +1,235 @@
+package numeric
+
+import "fmt"
+
+// Quantify returns n rounded to exactly places decimal places using mode.
+//
+// Numeric is a fixed-point type that always stores 36 decimal places, so the
+// result is canonical: values that are equal after rounding have identical
+// representations, and Scale (which ignores trailing zeros) never exceeds
+// places. Places outside the range 0 to 36 return NaN.
+func (n Numeric) Quantify(places int, mode RoundMode) Numeric {
+	if places > maxDecimalPlaces {
+		return NaN()
+	}
+	return n.Round(places, mode)
+}
+
+// RoundAdjustment returns the signed amount added to n by rounding it to
+// places decimal places using mode, i.e. n.Round(places, mode) - n, computed
+// at full precision. Rounding 1.239 to 2 places with RoundHalfUp gives 0.001.
+func (n Numeric) RoundAdjustment(places int, mode RoundMode) Numeric {
+	return n.Round(places, mode).Sub(n)
+}
+
+// RoundToward returns n rounded to the nearest value with places decimal
+// places, with ties rounded in the direction of reference, e.g. toward the
+// previous period's value to minimize change. Ties where reference equals n
+// round half even. A NaN reference returns NaN.
+func (n Numeric) RoundToward(places int, reference Numeric) Numeric {
+	if reference.z.isNaN() {
+		return NaN()
+	}
+	mode := RoundHalfEven
+	switch arith.order(&reference.z, &n.z) {
+	case 1: // ties toward positive infinity.
+		mode = RoundHalfUp
+		if n.z.isNeg() {
+			mode = RoundHalfDown
+		}
+	case -1: // ties toward negative infinity.
+		mode = RoundHalfDown
+		if n.z.isNeg() {
+			mode = RoundHalfUp
+		}
+	}
+	return n.Round(places, mode)
+}
+
+// InverseRate returns the inverse of an exchange rate rounded to places
+// decimal places, choosing the rounding direction so rate * inverse is as
+// close to 1 as possible. Of the two values at places either side of 1/rate,
+// the one giving the product nearest 1 is returned (the lower on a tie), so
+// the product is within rate/2 units of the last place of 1, and within one
+// unit when rate is at most 2.
+//
+// ErrNotANumber is returned for a NaN rate, ErrDivideByZero for zero,
+// ErrInvalidRate for negative or overflowing rates, ErrInvalidPlaces for
+// places outside 0 to 36 and ErrValueOutOfRange if the inverse rounds to zero.
+func InverseRate(rate Numeric, places int) (Numeric, error) {
+	switch {
+	case rate.IsNaN():
+		return NaN(), ErrNotANumber
+	case rate.IsZero():
+		return NaN(), ErrDivideByZero
+	case rate.IsNegative() || rate.HasOverflow():
+		return NaN(), fmt.Errorf("%w: %s", ErrInvalidRate, rate.String())
+	case places < 0 || places > maxDecimalPlaces:
+		return NaN(), fmt.Errorf("%w: %d", ErrInvalidPlaces, places)
+	}
+
+	one := One(false)
+	q := one.Div(rate)
+	lo := q.Round(places, RoundFloor)
+	if !q.HasUnderflow() && q.IsEqual(lo) {
+		return lo, nil
+	}
+
+	var unit f24
+	arith.pow10(&unit, -places)
+	hi := lo.Add(Numeric{z: unit})
+
+	// the products are nearest 1 for the inverse nearest 1/rate, so compare
+	// the full width rate * (lo + hi) / 2, the product at the midpoint, to 1.
+	inverse := lo
+	if MulDiv(rate, lo.Add(hi), FromInt(2)).IsLessThan(one) {
+		inverse = hi
+	}
+	if inverse.IsZero() {
+		return NaN(), fmt.Errorf("%w: inverse of %s at %d places", ErrValueOutOfRange, rate.String(), places)
+	}
+	return inverse, nil
+}
+
+// Quantize returns n rounded to a multiple of step using mode, e.g. rounding
+// a price to its tick size: 12.37 quantized to 0.05 with RoundHalfUp is 12.35.
+// A zero or NaN step, or a NaN n, returns NaN; an overflowing n stays overflowed.
+func (n Numeric) Quantize(step Numeric, mode RoundMode) Numeric {
+	if n.HasOverflow() && !n.IsNaN() && !step.IsNaN() && !step.IsZero() {
+		return Numeric{z: overflow(n.z.isNeg())}
+	}
+	var z f24
+	arith.quanta(&z, &n.z, &step.z, mode)
+	return Numeric{z: z}
+}
+
+// QuantizeRem returns n quantized to a multiple of step using mode, as
+// Quantize does, and the residual n - quantized, so the two sum back to n
+// exactly. The residual is negative when n was rounded up. A zero or NaN
+// step, or a NaN n, returns two NaNs; an overflowing n returns the overflow
+// and a NaN residual.
+func (n Numeric) QuantizeRem(step Numeric, mode RoundMode) (quantized, residual Numeric) {
+	quantized = n.Quantize(step, mode)
+	if quantized.IsNaN() {
+		return NaN(), NaN()
+	}
+	if quantized.HasOverflow() {
+		return quantized, NaN()
+	}
+	return quantized, n.Sub(quantized)
+}
+
+// RoundFromLeading returns n rounded to keep placesAfterLeading digits counted
+// from its leading significant digit, so 0.0001234 rounded with 2 is 0.00012.
+// Rounding never moves left of the decimal point: values of 1 or more are
+// rounded to at most whole units. A negative placesAfterLeading returns NaN.
+func (n Numeric) RoundFromLeading(placesAfterLeading int, mode RoundMode) Numeric {
+	if placesAfterLeading < 0 {
+		return NaN()
+	}
+	exp, ok := arith.log10Floor(&n.z)
+	if !ok {
+		return n.Round(0, mode)
+	}
+	return n.Round(max(placesAfterLeading-exp-1, 0), mode)
+}
+
+const (
+	// DialectPostgres matches PostgreSQL ROUND on NUMERIC values.
+	DialectPostgres Dialect = iota
+
+	// DialectMySQL matches MySQL ROUND on exact DECIMAL values.
+	DialectMySQL
+
+	// DialectSQLServer matches SQL Server ROUND on DECIMAL/NUMERIC values.
+	DialectSQLServer
+
+	// DialectOracle matches Oracle ROUND on NUMBER values.
+	DialectOracle
+
+	// DialectSQLite matches SQLite ROUND.
+	DialectSQLite
+)
+
+// Dialect identifies a SQL database whose ROUND() semantics RoundSQL reproduces.
+//
+// Every supported dialect rounds exact decimal columns with ties away from zero,
+// which is RoundHalfUp in this package. Approximate (float) columns are rounded
+// by the database after binary conversion and cannot be matched exactly.
+type Dialect int
+
+// dialectInfo maps a Dialect to its name and rounding mode.
+var dialectInfo = map[Dialect]struct {
+	name string
+	mode RoundMode
+}{
+	DialectPostgres:  {"postgres", RoundHalfUp},
+	DialectMySQL:     {"mysql", RoundHalfUp},
+	DialectSQLServer: {"sqlserver", RoundHalfUp},
+	DialectOracle:    {"oracle", RoundHalfUp},
+	DialectSQLite:    {"sqlite", RoundHalfUp},
+}
+
+// String returns the string name for the Dialect.
+func (d Dialect) String() string {
+	v, ok := dialectInfo[d]
+	if ok {
+		return v.name
+	}
+	return ""
+}
+
+// RoundMode returns the rounding mode used by the dialect's ROUND function.
+// The second result is false if the dialect is unknown.
+func (d Dialect) RoundMode() (RoundMode, bool) {
+	v, ok := dialectInfo[d]
+	return v.mode, ok
+}
+
+// RoundSQL returns n rounded to places decimal places the same way the
+// dialect's ROUND(n, places) would. An unknown dialect returns NaN.
+func (n Numeric) RoundSQL(places int, dialect Dialect) Numeric {
+	mode, ok := dialect.RoundMode()
+	if !ok {
+		return NaN()
+	}
+	return n.Round(places, mode)
+}
+
+// Rounder rounds a sequence of amounts, carrying the discarded residual of
+// each call into the next so the running total of the rounded values tracks
+// the running total of the unrounded amounts (error diffusion).
+// A Rounder is not safe for concurrent use.
+type Rounder struct {
+	places   int
+	mode     RoundMode
+	residual Numeric
+}
+
+// NewRounder returns a Rounder that rounds to places decimal places using mode.
+func NewRounder(places int, mode RoundMode) *Rounder {
+	return &Rounder{places: places, mode: mode}
+}
+
+// Round returns amount plus the carried residual, rounded to the Rounder's
+// places, and carries the newly discarded portion forward.
+// NaN and overflowing values are rounded without affecting the residual.
+func (r *Rounder) Round(amount Numeric) Numeric {
+	adjusted := amount.Add(r.residual)
+	rounded := adjusted.Round(r.places, r.mode)
+	if rounded.IsNaN() || rounded.HasOverflow() {
+		return amount.Round(r.places, r.mode)
+	}
+	r.residual = adjusted.Sub(rounded)
+	return rounded
+}
+
+// Residual returns the rounding residual carried into the next call to Round.
+func (r *Rounder) Residual() Numeric {
+	return r.residual
+}
+
+// Reset clears the carried residual.
+func (r *Rounder) Reset() {
+	r.residual = Zero
+}
@@ -0,0 +1,526 @@
+package numeric
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDialectString(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		want    string
+	}{
+		{DialectPostgres, "postgres"},
+		{DialectMySQL, "mysql"},
+		{DialectSQLServer, "sqlserver"},
+		{DialectOracle, "oracle"},
+		{DialectSQLite, "sqlite"},
+		{Dialect(99), ""},
+	}
+
+	for _, tc := range tests {
+		if got := tc.dialect.String(); got != tc.want {
+			t.Errorf("Dialect(%d).String() = %q, want %q", tc.dialect, got, tc.want)
+		}
+	}
+}
+
+func TestNumericRoundSQL(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		dialect  Dialect
+		expected string
+	}
+
+	tests := []testCase{
+		{"2.5", 0, DialectPostgres, "3"},
+		{"-2.5", 0, DialectPostgres, "-3"},
+		{"3.5", 0, DialectPostgres, "4"},
+		{"1.245", 2, DialectMySQL, "1.25"},
+		{"-1.245", 2, DialectMySQL, "-1.25"},
+		{"1.244", 2, DialectSQLServer, "1.24"},
+		{"0.5", 0, DialectOracle, "1"},
+		{"2.675", 2, DialectSQLite, "2.68"},
+		{"~1.5", 0, DialectPostgres, "2"},
+		{"NaN", 0, DialectPostgres, "NaN"},
+		{"1.5", 0, Dialect(99), "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.dialect.String(), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			got := n.RoundSQL(tc.places, tc.dialect).String()
+			if got != tc.expected {
+				t.Errorf("RoundSQL(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.dialect, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRounderSequence(t *testing.T) {
+	type testCase struct {
+		name     string
+		places   int
+		mode     RoundMode
+		amounts  []string
+		expected []string
+		total    string
+	}
+
+	tests := []testCase{
+		{
+			name:     "thirds",
+			places:   2,
+			mode:     RoundHalfUp,
+			amounts:  []string{"0.333", "0.333", "0.333"},
+			expected: []string{"0.33", "0.34", "0.33"},
+			total:    "1",
+		},
+		{
+			name:     "truncating",
+			places:   0,
+			mode:     RoundTowards,
+			amounts:  []string{"1.4", "1.4", "1.4", "1.4", "1.4"},
+			expected: []string{"1", "1", "2", "1", "2"},
+			total:    "7",
+		},
+		{
+			name:     "negative",
+			places:   1,
+			mode:     RoundHalfEven,
+			amounts:  []string{"-0.25", "-0.25", "-0.25", "-0.25"},
+			expected: []string{"-0.2", "-0.3", "-0.2", "-0.3"},
+			total:    "-1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRounder(tc.places, tc.mode)
+			var sum Numeric
+			for i, s := range tc.amounts {
+				a, err := FromString(s)
+				if err != nil {
+					t.Fatalf("FromString(%q) failed: %v", s, err)
+				}
+				got := r.Round(a)
+				if got.String() != tc.expected[i] {
+					t.Errorf("Round #%d(%q) = %q, want %q", i, s, got.String(), tc.expected[i])
+				}
+				sum = sum.Add(got)
+			}
+			if sum.String() != tc.total {
+				t.Errorf("total = %q, want %q", sum.String(), tc.total)
+			}
+		})
+	}
+}
+
+func TestRounderLongRunTotal(t *testing.T) {
+	r := NewRounder(2, RoundHalfUp)
+	amount := FromInt(10).Div(FromInt(3))
+	var rounded, unrounded Numeric
+	for range 1000 {
+		rounded = rounded.Add(r.Round(amount))
+		unrounded = unrounded.Add(amount)
+	}
+
+	half, _ := FromString("0.005")
+	if diff := rounded.Sub(unrounded).Abs(); diff.IsGreaterThan(half) {
+		t.Errorf("rounded total %s drifted from %s by %s", rounded, unrounded, diff)
+	}
+}
+
+func TestRounderExceptionalAndReset(t *testing.T) {
+	r := NewRounder(0, RoundHalfUp)
+	r.Round(FromFloat64(0.4))
+	residual := r.Residual().String()
+	if residual != "0.4" {
+		t.Fatalf("Residual() = %q, want %q", residual, "0.4")
+	}
+
+	if got := r.Round(NaN()); !got.IsNaN() {
+		t.Errorf("Round(NaN) = %q, want NaN", got.String())
+	}
+	if got := r.Residual().String(); got != residual {
+		t.Errorf("Residual() after NaN = %q, want %q", got, residual)
+	}
+
+	r.Reset()
+	if !r.Residual().IsZero() {
+		t.Errorf("Residual() after Reset = %q, want 0", r.Residual().String())
+	}
+}
+
+func TestNumericQuantify(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		mode     RoundMode
+		expected string
+	}
+
+	tests := []testCase{
+		{"1.5", 2, RoundHalfUp, "1.5"},
+		{"1.505", 2, RoundHalfUp, "1.51"},
+		{"1.505", 2, RoundHalfEven, "1.5"},
+		{"-1.505", 2, RoundTowards, "-1.5"},
+		{"~1.2345", 3, RoundHalfUp, "1.235"},
+		{"~0.333333333333333333333333333333333333", 36, RoundHalfUp, "0.333333333333333333333333333333333333"},
+		{"1.5", 37, RoundHalfUp, "NaN"},
+		{"1.5", -1, RoundHalfUp, "NaN"},
+		{"NaN", 2, RoundHalfUp, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			q := n.Quantify(tc.places, tc.mode)
+			if got := q.String(); got != tc.expected {
+				t.Errorf("Quantify(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.mode, got, tc.expected)
+			}
+			if !q.IsNaN() && q.Scale() > tc.places {
+				t.Errorf("Quantify(%q, %d).Scale() = %d", tc.input, tc.places, q.Scale())
+			}
+		})
+	}
+}
+
+func TestNumericQuantifyCanonical(t *testing.T) {
+	a, _ := FromString("2.50")
+	b, _ := FromString("2.4999")
+	qa, qb := a.Quantify(2, RoundHalfUp), b.Quantify(2, RoundHalfUp)
+	if qa != qb || qa.Scale() != qb.Scale() {
+		t.Errorf("Quantify(2) of %s and %s differ: %s, %s", a, b, qa, qb)
+	}
+}
+
+func TestNumericRoundAdjustment(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		mode     RoundMode
+		expected string
+	}
+
+	tests := []testCase{
+		{"1.239", 2, RoundHalfUp, "0.001"},
+		{"1.234", 2, RoundHalfUp, "-0.004"},
+		{"-1.235", 2, RoundHalfUp, "-0.005"},
+		{"-1.235", 2, RoundTowards, "0.005"},
+		{"1.23", 2, RoundHalfUp, "0"},
+		{"0.000000000000000000000000000000000001", 0, RoundAway, "0.999999999999999999999999999999999999"},
+		{"~1.239", 2, RoundHalfUp, "~0.001"},
+		{"NaN", 2, RoundHalfUp, "NaN"},
+		{"1.5", -1, RoundHalfUp, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			adj := n.RoundAdjustment(tc.places, tc.mode)
+			if got := adj.String(); got != tc.expected {
+				t.Errorf("RoundAdjustment(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.mode, got, tc.expected)
+			}
+			if !adj.IsUnderOverNaN() && !n.Add(adj).IsEqual(n.Round(tc.places, tc.mode)) {
+				t.Errorf("%q + adjustment %q != rounded", tc.input, adj)
+			}
+		})
+	}
+}
+
+func TestNumericRoundToward(t *testing.T) {
+	type testCase struct {
+		input     string
+		places    int
+		reference string
+		expected  string
+	}
+
+	tests := []testCase{
+		{"2.5", 0, "3", "3"},
+		{"2.5", 0, "0", "2"},
+		{"2.5", 0, "100", "3"},
+		{"-2.5", 0, "-3", "-3"},
+		{"-2.5", 0, "0", "-2"},
+		{"-2.5", 0, "-<1", "-3"},
+		{"2.5", 0, "<1", "3"},
+		{"1.245", 2, "1.2", "1.24"},
+		{"1.245", 2, "1.3", "1.25"},
+		{"2.5", 0, "2.5", "2"},
+		{"3.5", 0, "3.5", "4"},
+		{"2.6", 0, "0", "3"},
+		{"2.4", 0, "10", "2"},
+		{"-2.6", 0, "0", "-3"},
+		{"2.5", 0, "NaN", "NaN"},
+		{"NaN", 0, "1", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.reference, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			ref, _ := FromString(tc.reference)
+			if got := n.RoundToward(tc.places, ref).String(); got != tc.expected {
+				t.Errorf("RoundToward(%q, %d, %q) = %q, want %q", tc.input, tc.places, tc.reference, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInverseRate(t *testing.T) {
+	type testCase struct {
+		rate     string
+		places   int
+		expected string
+		err      error
+	}
+
+	tests := []testCase{
+		{"1.0856", 6, "0.92115", nil},
+		{"0.7931", 4, "1.2609", nil},
+		{"150.25", 4, "0.0067", nil},
+		{"1.3", 2, "0.77", nil},
+		{"1", 2, "1", nil},
+		{"4", 2, "0.25", nil},
+		{"3", 36, "0.333333333333333333333333333333333333", nil},
+		{"0.3", 36, "3.333333333333333333333333333333333333", nil},
+		{"8", 0, "", ErrValueOutOfRange},
+		{"0", 4, "", ErrDivideByZero},
+		{"NaN", 4, "", ErrNotANumber},
+		{"-1.2", 4, "", ErrInvalidRate},
+		{"<1", 4, "", ErrInvalidRate},
+		{"1.2", -1, "", ErrInvalidPlaces},
+		{"1.2", 37, "", ErrInvalidPlaces},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rate, func(t *testing.T) {
+			rate, _ := FromString(tc.rate)
+			inv, err := InverseRate(rate, tc.places)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("InverseRate(%q, %d) error = %v, want %v", tc.rate, tc.places, err, tc.err)
+			}
+			if err == nil && inv.String() != tc.expected {
+				t.Errorf("InverseRate(%q, %d) = %q, want %q", tc.rate, tc.places, inv, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInverseRateInvariant(t *testing.T) {
+	rates := []string{"1.0856", "1.2734", "0.8567", "1.9999", "0.5", "1.41421356", "0.0091", "7.8123", "156.87"}
+	one := One(false)
+
+	for _, s := range rates {
+		rate, _ := FromString(s)
+		for places := 2; places <= 12; places++ {
+			inv, err := InverseRate(rate, places)
+			if err != nil {
+				t.Fatalf("InverseRate(%s, %d) failed: %v", s, places, err)
+			}
+			if inv.Scale() > places {
+				t.Errorf("InverseRate(%s, %d) = %s has too many places", s, places, inv)
+			}
+
+			var u f24
+			arith.pow10(&u, -places)
+			unit := Numeric{z: u}
+			diff := rate.Mul(inv).Sub(one).Abs()
+			if bound := rate.Mul(unit).Div(FromInt(2)); diff.IsGreaterThan(bound) {
+				t.Errorf("InverseRate(%s, %d) = %s: |rate*inverse - 1| = %s exceeds %s", s, places, inv, diff, bound)
+			}
+			for _, other := range []Numeric{inv.Add(unit), inv.Sub(unit)} {
+				if rate.Mul(other).Sub(one).Abs().IsLessThan(diff) {
+					t.Errorf("InverseRate(%s, %d) = %s, but %s is closer", s, places, inv, other)
+				}
+			}
+		}
+	}
+}
+
+func TestNumericQuantize(t *testing.T) {
+	type testCase struct {
+		input, step string
+		mode        RoundMode
+		expected    string
+	}
+
+	tests := []testCase{
+		{"123.456", "0.01", RoundTowards, "123.45"},
+		{"123.456", "0.01", RoundAway, "123.46"},
+		{"123.456", "0.01", RoundHalfUp, "123.46"},
+		{"123.444", "0.01", RoundHalfUp, "123.44"},
+		{"123", "1", RoundTowards, "123"},
+		{"123", "1", RoundAway, "123"},
+		{"123", "1", RoundHalfUp, "123"},
+		{"1.49", "1", RoundHalfUp, "1"},
+		{"1.50", "1", RoundHalfUp, "2"},
+		{"-1.5", "1", RoundHalfUp, "-2"},
+		{"12.37", "0.05", RoundHalfUp, "12.35"},
+		{"12.375", "0.05", RoundHalfUp, "12.4"},
+		{"12.375", "0.05", RoundHalfEven, "12.4"},
+		{"-12.37", "0.25", RoundFloor, "-12.5"},
+		{"1003", "25", RoundHalfUp, "1000"},
+		{"NaN", "1", RoundHalfUp, "NaN"},
+		{"1", "0", RoundHalfUp, "NaN"},
+		{"1", "NaN", RoundHalfUp, "NaN"},
+		{"-<1", "0.05", RoundHalfUp, "-<999999999999999999.999999999999999999999999999999999999"},
+		{"<1", "0", RoundHalfUp, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.step, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			step, _ := FromString(tc.step)
+			if got := n.Quantize(step, tc.mode).String(); got != tc.expected {
+				t.Errorf("Quantize(%q, %q, %v) = %q, want %q", tc.input, tc.step, tc.mode, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericQuantizeRem(t *testing.T) {
+	tests := []struct {
+		input, step string
+		mode        RoundMode
+		quantized   string
+		residual    string
+	}{
+		{"123.456", "0.01", RoundTowards, "123.45", "0.006"},
+		{"123.456", "0.01", RoundHalfUp, "123.46", "-0.004"},
+		{"12.37", "0.05", RoundHalfUp, "12.35", "0.02"},
+		{"12.38", "0.05", RoundHalfUp, "12.4", "-0.02"},
+		{"-12.37", "0.25", RoundFloor, "-12.5", "0.13"},
+		{"-12.37", "0.25", RoundCeil, "-12.25", "-0.12"},
+		{"1003", "25", RoundHalfUp, "1000", "3"},
+		{"1000", "25", RoundHalfUp, "1000", "0"},
+		{"0.000000000000000000000000000000000007", "0.000000000000000000000000000000000005", RoundHalfUp, "0.000000000000000000000000000000000005", "0.000000000000000000000000000000000002"},
+		{"1", "0", RoundHalfUp, "NaN", "NaN"},
+		{"1", "NaN", RoundHalfUp, "NaN", "NaN"},
+		{"NaN", "1", RoundHalfUp, "NaN", "NaN"},
+		{"-<1", "0.05", RoundHalfUp, "-<999999999999999999.999999999999999999999999999999999999", "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.step, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			step, _ := FromString(tc.step)
+			q, r := n.QuantizeRem(step, tc.mode)
+			if q.String() != tc.quantized || r.String() != tc.residual {
+				t.Errorf("QuantizeRem(%q, %q, %v) = %q, %q, want %q, %q", tc.input, tc.step, tc.mode, q, r, tc.quantized, tc.residual)
+			}
+			if !q.IsIdentical(n.Quantize(step, tc.mode)) && !q.IsNaN() {
+				t.Errorf("QuantizeRem(%q, %q, %v) quantized %q differs from Quantize", tc.input, tc.step, tc.mode, q)
+			}
+			if !n.IsUnderOverNaN() && !q.IsNaN() && !q.Add(r).IsEqual(n) {
+				t.Errorf("QuantizeRem(%q, %q, %v) sum %q, want %q", tc.input, tc.step, tc.mode, q.Add(r), n)
+			}
+		})
+	}
+}
+
+func TestNumericRoundFromLeading(t *testing.T) {
+	type testCase struct {
+		input    string
+		places   int
+		mode     RoundMode
+		expected string
+	}
+
+	tests := []testCase{
+		{"0.0001234", 2, RoundHalfUp, "0.00012"},
+		{"0.0001234", 3, RoundHalfUp, "0.000123"},
+		{"0.0001235", 4, RoundHalfEven, "0.0001235"},
+		{"0.0001235", 3, RoundHalfEven, "0.000124"},
+		{"-0.0001235", 3, RoundTowards, "-0.000123"},
+		{"0.000999", 1, RoundHalfUp, "0.001"},
+		{"0.5", 1, RoundHalfUp, "0.5"},
+		{"0.0001234", 0, RoundHalfUp, "0"},
+		{"1.2345", 3, RoundHalfUp, "1.23"},
+		{"1234.5678", 2, RoundHalfUp, "1235"},
+		{"~0.000000000000000000000000000000000123", 1, RoundHalfUp, "0.0000000000000000000000000000000001"},
+		{"0", 2, RoundHalfUp, "0"},
+		{"NaN", 2, RoundHalfUp, "NaN"},
+		{"1.5", -1, RoundHalfUp, "NaN"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.input, err)
+			}
+			got := n.RoundFromLeading(tc.places, tc.mode).String()
+			if got != tc.expected {
+				t.Errorf("RoundFromLeading(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.mode, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNumericRoundAtLastPlace(t *testing.T) {
+	const (
+		half    = "0.000000000000000000000000000000000005"  // exactly half a unit of the 35th place.
+		halfEps = "0.0000000000000000000000000000000000051" // half plus a sub-ULP epsilon, lost on parsing.
+		oneEps  = "0.0000000000000000000000000000000000015" // one unit of the 36th place plus a lost half.
+		unit35  = "0.00000000000000000000000000000000001"   // one unit of the 35th place.
+		unit36  = "0.000000000000000000000000000000000001"  // one unit of the 36th place.
+		odd     = "1.000000000000000000000000000000000015"  // tie with an odd kept digit at the 35th place.
+		oddUp   = "1.00000000000000000000000000000000002"   // odd rounded up to the 35th place.
+		oddDown = "1.00000000000000000000000000000000001"   // odd rounded down to the 35th place.
+	)
+
+	tests := []struct {
+		input    string
+		places   int
+		mode     RoundMode
+		expected string
+	}{
+		{half, 35, RoundHalfUp, unit35},
+		{half, 35, RoundHalfDown, "0"},
+		{half, 35, RoundHalfEven, "0"},
+		{"-" + half, 35, RoundHalfUp, "-" + unit35},
+		{"-" + half, 35, RoundHalfDown, "0"},
+		{odd, 35, RoundHalfEven, oddUp},
+		{odd, 35, RoundHalfDown, oddDown},
+
+		// the epsilon is below the representable limit, so it is lost and
+		// the value rounds exactly as the stored tie does.
+		{halfEps, 35, RoundHalfUp, unit35},
+		{halfEps, 35, RoundHalfDown, "0"},
+		{halfEps, 35, RoundHalfEven, "0"},
+		{"-" + halfEps, 35, RoundHalfDown, "0"},
+
+		// at 36 places every stored digit is kept whatever the mode.
+		{unit36, 36, RoundHalfUp, unit36},
+		{unit36, 36, RoundTowards, unit36},
+		{oneEps, 36, RoundHalfUp, unit36},
+		{oneEps, 36, RoundHalfDown, unit36},
+		{oneEps, 36, RoundCeil, unit36},
+		{oneEps, 36, RoundAway, unit36},
+		{"-" + oneEps, 36, RoundFloor, "-" + unit36},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input+"_"+tc.mode.String(), func(t *testing.T) {
+			n, err := FromString(tc.input)
+			if err != nil {
+				t.Fatalf("FromString(%q): %v", tc.input, err)
+			}
+			got := n.Round(tc.places, tc.mode)
+			if got.String() != tc.expected {
+				t.Errorf("Round(%q, %d, %v) = %q, want %q", tc.input, tc.places, tc.mode, got, tc.expected)
+			}
+			if got.HasUnderflow() {
+				t.Errorf("Round(%q, %d, %v) kept the underflow flag", tc.input, tc.places, tc.mode)
+			}
+		})
+	}
+}
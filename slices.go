@@ -0,0 +1,46 @@
+package numeric
+
+import (
+	"slices"
+)
+
+// BinarySearch searches for target in xs, which must be sorted in ascending
+// order as by SortSlice or Slice. It returns the position where target is
+// found, or the position where it would be inserted, and whether it was found.
+// Values are compared in the same order as the sort, so NaN and overflows
+// are found like any other value.
+func BinarySearch(xs []Numeric, target Numeric) (int, bool) {
+	return slices.BinarySearchFunc(xs, target, func(e, t Numeric) int {
+		return sortOrder(&e.z, &t.z)
+	})
+}
+
+// Slice attaches the methods of sort.Interface to []Numeric, sorting in
+// ascending order. NaN values sort first, then the other values in order,
+// with overflows beyond every representable value of the same sign.
+type Slice []Numeric
+
+func (s Slice) Len() int           { return len(s) }
+func (s Slice) Less(i, j int) bool { return sortOrder(&s[i].z, &s[j].z) < 0 }
+func (s Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// SortSlice sorts xs in ascending order, ordering values like Slice.
+func SortSlice(xs []Numeric) {
+	slices.SortFunc(xs, func(a, b Numeric) int {
+		return sortOrder(&a.z, &b.z)
+	})
+}
+
+// sortOrder is a total order for sorting: NaN first, then values by arith.order.
+func sortOrder(x, y *f24) int {
+	xNaN, yNaN := x.isNaN(), y.isNaN()
+	switch {
+	case xNaN && yNaN:
+		return 0
+	case xNaN:
+		return -1
+	case yNaN:
+		return 1
+	}
+	return arith.order(x, y)
+}
@@ -0,0 +1,134 @@
+package numeric
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	var xs []Numeric
+	for _, s := range []string{"-10", "-1.5", "0", "0.25", "3", "3", "1000"} {
+		n, err := FromString(s)
+		if err != nil {
+			t.Fatalf("FromString(%q) failed: %v", s, err)
+		}
+		xs = append(xs, n)
+	}
+
+	type testCase struct {
+		target    string
+		wantIdx   int
+		wantFound bool
+	}
+
+	tests := []testCase{
+		{"-11", 0, false},
+		{"-10", 0, true},
+		{"-1.5", 1, true},
+		{"-1", 2, false},
+		{"0", 2, true},
+		{"0.2", 3, false},
+		{"0.25", 3, true},
+		{"3", 4, true},
+		{"999", 6, false},
+		{"1000", 6, true},
+		{"1000.000000000000000000000000000000000001", 7, false},
+		{"NaN", 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.target, func(t *testing.T) {
+			target, err := FromString(tc.target)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", tc.target, err)
+			}
+			idx, found := BinarySearch(xs, target)
+			if idx != tc.wantIdx || found != tc.wantFound {
+				t.Errorf("BinarySearch(%q) = (%d, %v), want (%d, %v)", tc.target, idx, found, tc.wantIdx, tc.wantFound)
+			}
+		})
+	}
+}
+
+func TestBinarySearchSorted(t *testing.T) {
+	var xs []Numeric
+	for _, s := range []string{"5", "<1", "1", "NaN", "-<1", "3"} {
+		n, _ := FromString(s)
+		xs = append(xs, n)
+	}
+	SortSlice(xs)
+
+	tests := []struct {
+		target    string
+		wantIdx   int
+		wantFound bool
+	}{
+		{"NaN", 0, true},
+		{"-<1", 1, true},
+		{"-999999999999999999", 2, false},
+		{"1", 2, true},
+		{"3", 3, true},
+		{"5", 4, true},
+		{"999999999999999999", 5, false},
+		{"<1", 5, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.target, func(t *testing.T) {
+			target, _ := FromString(tc.target)
+			idx, found := BinarySearch(xs, target)
+			if idx != tc.wantIdx || found != tc.wantFound {
+				t.Errorf("BinarySearch(%v, %q) = (%d, %v), want (%d, %v)", xs, tc.target, idx, found, tc.wantIdx, tc.wantFound)
+			}
+		})
+	}
+}
+
+func TestBinarySearchEmpty(t *testing.T) {
+	idx, found := BinarySearch(nil, One(false))
+	if idx != 0 || found {
+		t.Errorf("BinarySearch(nil) = (%d, %v), want (0, false)", idx, found)
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	input := []string{"3", "NaN", "<1", "-0.5", "~0.5", "0.5", "-<1", "0", "~-0.5", "NaN", "-999999999999999999", "1e-36"}
+	want := []string{
+		"NaN", "NaN", "-<999999999999999999.999999999999999999999999999999999999",
+		"-999999999999999999", "~-0.5", "-0.5", "0", "0.000000000000000000000000000000000001",
+		"0.5", "~0.5", "3", "<999999999999999999.999999999999999999999999999999999999",
+	}
+
+	sorters := map[string]func([]Numeric){
+		"sort.Sort": func(xs []Numeric) { sort.Sort(Slice(xs)) },
+		"SortSlice": SortSlice,
+	}
+
+	for name, sorter := range sorters {
+		t.Run(name, func(t *testing.T) {
+			var xs []Numeric
+			for _, s := range input {
+				n, err := FromString(s)
+				if err != nil {
+					t.Fatalf("FromString(%q) failed: %v", s, err)
+				}
+				xs = append(xs, n)
+			}
+			// sort a reversed copy too, so the result does not depend on input order.
+			ys := slices.Clone(xs)
+			slices.Reverse(ys)
+
+			for _, v := range [][]Numeric{xs, ys} {
+				sorter(v)
+				var got []string
+				for _, n := range v {
+					got = append(got, n.String())
+				}
+				if !slices.Equal(got, want) {
+					t.Errorf("sorted = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
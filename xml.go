@@ -0,0 +1,37 @@
+package numeric
+
+import "encoding/xml"
+
+// MarshalXML implements xml.Marshaler, writing the String form as the
+// element text. NaN is written as "NaN".
+func (n Numeric) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(n.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler, parsing the element text with
+// FromString. An empty element decodes to NaN.
+func (n *Numeric) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	nn, err := FromString(s)
+	if err != nil {
+		return err
+	}
+	*n = nn
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler, writing NaN as an empty element.
+func (e EmptyNaN) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	if e.IsNaN() {
+		return enc.EncodeElement("", start)
+	}
+	return e.Numeric.MarshalXML(enc, start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler, decoding an empty element as NaN.
+func (e *EmptyNaN) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return e.Numeric.UnmarshalXML(d, start)
+}
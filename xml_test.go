@@ -0,0 +1,117 @@
+package numeric
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type xmlPrice struct {
+	XMLName  xml.Name `xml:"price"`
+	Currency string   `xml:"currency,attr"`
+	Amount   Numeric  `xml:"amount"`
+}
+
+func TestNumericXMLRoundTrip(t *testing.T) {
+	inputs := []string{
+		"0", "123.456", "-0.000000000000000000000000000000000001",
+		"~0.333", "<1", "-<1", "NaN",
+	}
+
+	for _, s := range inputs {
+		t.Run(s, func(t *testing.T) {
+			n, err := FromString(s)
+			if err != nil {
+				t.Fatalf("FromString(%q) failed: %v", s, err)
+			}
+
+			data, err := xml.Marshal(xmlPrice{Currency: "EUR", Amount: n})
+			if err != nil {
+				t.Fatalf("Marshal(%q) failed: %v", s, err)
+			}
+			want := `<price currency="EUR"><amount>` + strings.ReplaceAll(n.String(), "<", "&lt;") + `</amount></price>`
+			if string(data) != want {
+				t.Errorf("Marshal(%q) = %s, want %s", s, data, want)
+			}
+
+			var got xmlPrice
+			if err := xml.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%s) failed: %v", data, err)
+			}
+			if !got.Amount.IsIdentical(n) {
+				t.Errorf("round trip of %q = %q", s, got.Amount)
+			}
+		})
+	}
+}
+
+func TestNumericUnmarshalXML(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{`<price><amount>1.25</amount></price>`, "1.25", nil},
+		{`<price><amount> -7 </amount></price>`, "-7", nil},
+		{`<price><amount></amount></price>`, "NaN", nil},
+		{`<price><amount/></price>`, "NaN", nil},
+		{`<price><amount>NaN</amount></price>`, "NaN", nil},
+		{`<price><amount>1.2.3</amount></price>`, "", ErrParseFormatNumeric},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			var got xmlPrice
+			err := xml.Unmarshal([]byte(tc.input), &got)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("Unmarshal(%s) error = %v, want %v", tc.input, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal(%s) failed: %v", tc.input, err)
+			}
+			if s := got.Amount.String(); s != tc.expected {
+				t.Errorf("Unmarshal(%s) = %q, want %q", tc.input, s, tc.expected)
+			}
+		})
+	}
+}
+
+func TestEmptyNaNXML(t *testing.T) {
+	type row struct {
+		XMLName xml.Name `xml:"row"`
+		Amount  EmptyNaN `xml:"amount"`
+	}
+	tests := []struct {
+		input string
+		xml   string
+	}{
+		{"NaN", `<row><amount></amount></row>`},
+		{"1.25", `<row><amount>1.25</amount></row>`},
+		{"~0.5", `<row><amount>~0.5</amount></row>`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			n, _ := FromString(tc.input)
+			data, err := xml.Marshal(row{Amount: EmptyNaN{n}})
+			if err != nil {
+				t.Fatalf("Marshal(%q) failed: %v", tc.input, err)
+			}
+			if string(data) != tc.xml {
+				t.Errorf("Marshal(%q) = %s, want %s", tc.input, data, tc.xml)
+			}
+
+			var got row
+			if err := xml.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%s) failed: %v", data, err)
+			}
+			if !got.Amount.IsIdentical(n) {
+				t.Errorf("round trip of %q = %q", tc.input, got.Amount)
+			}
+		})
+	}
+}